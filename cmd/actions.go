@@ -0,0 +1,295 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/bashfulrobot/meetsum/config"
+	"github.com/bashfulrobot/meetsum/internal/actions"
+	"github.com/bashfulrobot/meetsum/internal/summary"
+	"github.com/bashfulrobot/meetsum/internal/tracker"
+	"github.com/bashfulrobot/meetsum/internal/ui"
+	"github.com/charmbracelet/huh"
+	"github.com/spf13/cobra"
+)
+
+var (
+	actionsFormat string
+	actionsOutput string
+	actionsMine   bool
+	pushTo        string
+	pushAll       bool
+)
+
+// trackerPushTimeout bounds a full push, including assignee lookups.
+const trackerPushTimeout = 2 * time.Minute
+
+// actionsCmd groups action item commands
+var actionsCmd = &cobra.Command{
+	Use:   "actions",
+	Short: "Work with action items from generated summaries",
+	Long:  `Extract the ACTION ITEMS section from a generated summary and move it into other tools.`,
+}
+
+// actionsExportCmd exports action items to task formats
+var actionsExportCmd = &cobra.Command{
+	Use:   "export [meeting_directory]",
+	Short: "Export action items as iCalendar VTODO, todo.txt or CSV",
+	Long: `Parse the ACTION ITEMS section of the meeting's summary and export each item as a task.
+
+Relative deadlines such as "by Friday" or "next week" are resolved against the
+meeting date taken from the directory path. Each task references the customer,
+meeting date and summary file. Use --mine to keep only items assigned to the
+configured user.name.`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeMeetingDir,
+	RunE:              runActionsExport,
+}
+
+// actionsPushCmd creates tracker issues from action items
+var actionsPushCmd = &cobra.Command{
+	Use:   "push [meeting_directory]",
+	Short: "Create GitHub, GitLab or Jira issues from action items",
+	Long: `Parse the ACTION ITEMS section of the meeting's summary, choose which items
+to turn into issues, and create them in the tracker given by --to.
+
+Assignees are mapped to tracker usernames through trackers.assignees in
+settings.yaml; unmapped people are left unassigned. Each issue links back to
+the summary file. Created issue IDs are recorded in .meetsum/tracker-issues.json
+so running the command again never creates the same issue twice.`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeMeetingDir,
+	RunE:              runActionsPush,
+}
+
+func runActionsPush(cmd *cobra.Command, args []string) error {
+	items, meeting, cfg, err := loadMeetingActionItems(args[0])
+	if err != nil {
+		return err
+	}
+	client, err := newTrackerClient(cfg, pushTo)
+	if err != nil {
+		return err
+	}
+	if actionsMine {
+		name := strings.TrimSpace(cfg.User.Name)
+		if name == "" {
+			return fmt.Errorf("--mine requires user.name to be configured in settings.yaml")
+		}
+		items = actions.FilterByAssignee(items, name)
+	}
+
+	ledger, err := tracker.LoadLedger(filepath.Dir(meeting.SummaryPath))
+	if err != nil {
+		return err
+	}
+
+	pending, done := ledger.Pending(pushTo, items, meeting)
+	for _, record := range done {
+		fmt.Println(ui.RenderInfo(fmt.Sprintf("⏭️  Already created %s: %s", record.IssueID, record.Task)))
+	}
+	if len(pending) == 0 {
+		fmt.Println(ui.RenderSuccess("No new action items to push"))
+		return nil
+	}
+
+	selected := pending
+	if !pushAll {
+		selected, err = selectActionItems(pending)
+		if err != nil {
+			return err
+		}
+		if len(selected) == 0 {
+			fmt.Println(ui.RenderInfo("No action items selected"))
+			return nil
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), trackerPushTimeout)
+	defer cancel()
+
+	results, err := tracker.Push(ctx, tracker.PushOptions{
+		Tracker: pushTo,
+		Client:  client,
+		Ledger:  ledger,
+		Labels:  trackerLabels(cfg, pushTo),
+		UserFor: func(person string) string { return cfg.GetTrackerUser(pushTo, person) },
+	}, selected, meeting)
+
+	failed := 0
+	for _, result := range results {
+		switch {
+		case result.Err != nil:
+			failed++
+			fmt.Println(ui.RenderError(fmt.Sprintf("%s: %v", result.Item.Task, result.Err)))
+		case result.Existing:
+			fmt.Println(ui.RenderInfo(fmt.Sprintf("⏭️  Already created %s: %s", result.Record.IssueID, result.Item.Task)))
+		default:
+			fmt.Println(ui.RenderSuccess(fmt.Sprintf("Created %s: %s", result.Record.IssueID, result.Record.IssueURL)))
+		}
+		if len(result.Unmapped) > 0 {
+			fmt.Println(ui.RenderWarning(fmt.Sprintf("No %s username for %s; add them to trackers.assignees", pushTo, strings.Join(result.Unmapped, ", "))))
+		}
+	}
+
+	if err != nil {
+		return fmt.Errorf("issues were created but recording them failed: %w", err)
+	}
+	if failed > 0 {
+		cmd.SilenceUsage = true
+		return fmt.Errorf("%d of %d issue(s) could not be created", failed, len(results))
+	}
+	return nil
+}
+
+// selectActionItems shows a multi-select of items, all selected by default.
+func selectActionItems(items []actions.Item) ([]actions.Item, error) {
+	options := make([]huh.Option[int], 0, len(items))
+	for i, item := range items {
+		label := item.Task
+		if item.Assignee != "" {
+			label = item.Assignee + ": " + label
+		}
+		if item.HasDue() {
+			label += fmt.Sprintf(" (due %s)", item.Due.Format("2006-01-02"))
+		}
+		options = append(options, huh.NewOption(label, i).Selected(true))
+	}
+
+	var chosen []int
+	err := huh.NewMultiSelect[int]().
+		Title("Action items to create as issues").
+		Description("Space toggles, enter confirms").
+		Options(options...).
+		Value(&chosen).
+		Run()
+	if err != nil {
+		return nil, err
+	}
+
+	selected := make([]actions.Item, 0, len(chosen))
+	for _, index := range chosen {
+		selected = append(selected, items[index])
+	}
+	return selected, nil
+}
+
+// newTrackerClient builds the client for a tracker from the meeting's settings.
+func newTrackerClient(cfg *config.Config, name string) (tracker.Client, error) {
+	trackers := cfg.Trackers
+	token := cfg.GetTrackerToken(name)
+
+	switch name {
+	case tracker.GitHub:
+		return tracker.NewGitHubClient(trackers.GitHub.APIURL, token, trackers.GitHub.Repo)
+	case tracker.GitLab:
+		return tracker.NewGitLabClient(trackers.GitLab.APIURL, token, trackers.GitLab.Project)
+	case tracker.Jira:
+		return tracker.NewJiraClient(trackers.Jira.URL, trackers.Jira.Email, token, trackers.Jira.Project, trackers.Jira.IssueType)
+	default:
+		return nil, fmt.Errorf("unsupported tracker %q; use one of: %s", name, strings.Join(tracker.Names, ", "))
+	}
+}
+
+func trackerLabels(cfg *config.Config, name string) []string {
+	switch name {
+	case tracker.GitHub:
+		return cfg.Trackers.GitHub.Labels
+	case tracker.GitLab:
+		return cfg.Trackers.GitLab.Labels
+	case tracker.Jira:
+		return cfg.Trackers.Jira.Labels
+	}
+	return nil
+}
+
+func runActionsExport(cmd *cobra.Command, args []string) error {
+	// Check the format before -o creates or truncates the output file
+	if err := actions.ValidateFormat(actionsFormat); err != nil {
+		return err
+	}
+
+	items, meeting, cfg, err := loadMeetingActionItems(args[0])
+	if err != nil {
+		return err
+	}
+
+	if actionsMine {
+		name := strings.TrimSpace(cfg.User.Name)
+		if name == "" {
+			return fmt.Errorf("--mine requires user.name to be configured in settings.yaml")
+		}
+		items = actions.FilterByAssignee(items, name)
+	}
+
+	var out io.Writer = cmd.OutOrStdout()
+	if actionsOutput != "" && actionsOutput != "-" {
+		file, err := os.Create(expandPath(actionsOutput))
+		if err != nil {
+			return fmt.Errorf("failed to create %s: %w", actionsOutput, err)
+		}
+		defer file.Close()
+		out = file
+	}
+
+	if err := actions.Export(out, actionsFormat, items, meeting, time.Now()); err != nil {
+		return err
+	}
+
+	if actionsOutput != "" && actionsOutput != "-" {
+		fmt.Fprintln(cmd.ErrOrStderr(), ui.RenderSuccess(fmt.Sprintf("Exported %d action item(s) to %s", len(items), actionsOutput)))
+	}
+	return nil
+}
+
+// loadMeetingActionItems reads the saved summary for a meeting directory and
+// parses its action items with deadlines resolved against the meeting date.
+// It also returns the meeting's configuration with its .meetsum.yaml
+// overrides applied.
+func loadMeetingActionItems(dir string) ([]actions.Item, actions.Meeting, *config.Config, error) {
+	resolvedDir, err := resolveMeetingDir(dir)
+	if err != nil {
+		return nil, actions.Meeting{}, nil, err
+	}
+	dirConfig, err := config.AppConfig.ForDir(resolvedDir)
+	if err != nil {
+		return nil, actions.Meeting{}, nil, err
+	}
+
+	processor := summary.NewProcessor(dirConfig.Config, logger)
+	processor.SetMeetingDir(resolvedDir)
+
+	content, summaryPath, err := processor.LoadSavedSummary()
+	if err != nil {
+		return nil, actions.Meeting{}, nil, err
+	}
+
+	customer, _ := processor.ExtractCustomerName()
+	meeting := actions.Meeting{
+		Customer:    customer,
+		Date:        processor.ExtractDateFromPath(),
+		SummaryPath: summaryPath,
+	}
+
+	return actions.ParseSummary(content, meeting.Date), meeting, dirConfig.Config, nil
+}
+
+func init() {
+	rootCmd.AddCommand(actionsCmd)
+	actionsCmd.AddCommand(actionsExportCmd)
+	actionsCmd.AddCommand(actionsPushCmd)
+
+	actionsExportCmd.Flags().StringVar(&actionsFormat, "format", actions.FormatICS, "Export format: "+strings.Join(actions.Formats, "|"))
+	actionsExportCmd.Flags().StringVarP(&actionsOutput, "output", "o", "", "Write to file instead of stdout")
+	actionsExportCmd.Flags().BoolVar(&actionsMine, "mine", false, "Only export items assigned to the configured user.name")
+
+	actionsPushCmd.Flags().StringVar(&pushTo, "to", "", "Tracker to create issues in: "+strings.Join(tracker.Names, "|"))
+	actionsPushCmd.Flags().BoolVar(&pushAll, "all", false, "Create issues for every new item without prompting")
+	actionsPushCmd.Flags().BoolVar(&actionsMine, "mine", false, "Only push items assigned to the configured user.name")
+	_ = actionsPushCmd.MarkFlagRequired("to")
+}
@@ -0,0 +1,247 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/bashfulrobot/meetsum/config"
+	"github.com/bashfulrobot/meetsum/internal/app"
+	"github.com/bashfulrobot/meetsum/internal/batch"
+	"github.com/bashfulrobot/meetsum/internal/meetings"
+	"github.com/bashfulrobot/meetsum/internal/ui"
+	"github.com/charmbracelet/huh"
+	"github.com/spf13/cobra"
+)
+
+var (
+	batchYes     bool
+	batchWorkers int
+	batchRate    int
+	batchName    string
+)
+
+// batchCmd summarizes every pending meeting under a root directory
+var batchCmd = &cobra.Command{
+	Use:   "batch [root_directory]",
+	Short: "Summarize every meeting directory that has a transcript but no summary",
+	Long: `Walk paths.file_browser_root_dir (or the given directory) and find meeting
+directories with exactly one transcript and no summary file. After you confirm
+the list, the meetings are summarized by a pool of workers (batch.workers)
+with an optional per-provider limit on runs started per minute
+(batch.rate_limits), followed by a success/failure table.
+
+Use --yes to skip the confirmation in scripts.`,
+	Args:              cobra.MaximumNArgs(1),
+	ValidArgsFunction: completeMeetingDir,
+	RunE:              runBatch,
+}
+
+func runBatch(cmd *cobra.Command, args []string) error {
+	cfg := config.AppConfig
+	cmd.SilenceUsage = true
+	interactive := stdinIsTerminal()
+
+	root := cfg.Paths.FileBrowserRootDir
+	if len(args) > 0 {
+		root = args[0]
+	}
+	if root == "" {
+		return withExitCode(ExitMissingInput, fmt.Errorf("no directory to scan: pass one or set paths.file_browser_root_dir"))
+	}
+	root, err := resolveMeetingDir(root)
+	if err != nil {
+		return withExitCode(ExitMissingInput, err)
+	}
+
+	runtimeService := app.NewService(cfg, logger)
+	aiCommand, err := runtimeService.Preflight()
+	if err != nil {
+		fmt.Println(ui.RenderError(err.Error()))
+		return withExitCode(ExitPreflight, err)
+	}
+
+	found, err := meetings.Scan(cfg, root)
+	if err != nil {
+		return err
+	}
+	var pending []meetings.Meeting
+	for _, meeting := range found {
+		if meeting.Pending() {
+			pending = append(pending, meeting)
+		}
+	}
+	if len(pending) == 0 {
+		fmt.Println(ui.RenderSuccess(fmt.Sprintf("No pending meetings under %s", root)))
+		return nil
+	}
+
+	switch {
+	case batchYes:
+		fmt.Println(ui.RenderInfo(fmt.Sprintf("📋 %d meeting(s) to summarize:", len(pending))))
+		for _, meeting := range pending {
+			fmt.Println(ui.FileListStyle.Render("  " + batchLabel(root, meeting)))
+		}
+	case interactive:
+		if pending, err = selectBatchMeetings(root, pending); err != nil {
+			return err
+		}
+		if len(pending) == 0 {
+			fmt.Println(ui.RenderInfo("No meetings selected."))
+			return nil
+		}
+	default:
+		return withExitCode(ExitMissingInput, fmt.Errorf("%d pending meeting(s) found; pass --yes to summarize them without confirmation", len(pending)))
+	}
+
+	userName, err := resolveUserName(batchName, interactive)
+	if err != nil {
+		return err
+	}
+
+	options := batch.Options{Workers: cfg.GetBatchWorkers(), PerMinute: cfg.GetBatchRateLimit(aiCommand)}
+	if cmd.Flags().Changed("workers") {
+		options.Workers = batchWorkers
+	}
+	if cmd.Flags().Changed("rate") {
+		options.PerMinute = batchRate
+	}
+
+	rate := "unlimited"
+	if options.PerMinute > 0 {
+		rate = fmt.Sprintf("%d run(s)/minute", options.PerMinute)
+	}
+	fmt.Println(ui.RenderInfoBox(
+		fmt.Sprintf("🤖 Provider: %s", aiCommand),
+		fmt.Sprintf("👷 Workers: %d", max(options.Workers, 1)),
+		fmt.Sprintf("⏱️  Rate limit: %s", rate),
+	))
+
+	run := func(meeting meetings.Meeting) (app.RunResult, error) {
+		session, err := runtimeService.Prepare(app.RunRequest{UserName: userName, MeetingDir: meeting.Dir})
+		if err != nil {
+			return app.RunResult{}, err
+		}
+		return session.Run()
+	}
+
+	var outcomes []batch.Outcome
+	if interactive && !cfg.Features.TraceMode {
+		labels := make([]string, len(pending))
+		for i, meeting := range pending {
+			labels[i] = batchLabel(root, meeting)
+		}
+		err = ui.RunBoard(fmt.Sprintf("🧠 Summarizing %d meeting(s)", len(pending)), labels, func(ctx context.Context, update func(ui.BoardUpdate)) {
+			outcomes = batch.Run(ctx, pending, options, run, func(u batch.Update) {
+				update(ui.BoardUpdate{
+					Index:  u.Index,
+					Status: u.State.String(),
+					Active: u.State == batch.StateRunning,
+					Done:   u.State == batch.StateSucceeded,
+					Failed: u.State == batch.StateFailed || u.State == batch.StateCanceled,
+				})
+			})
+		})
+		if err != nil {
+			return err
+		}
+	} else {
+		ctx, stop := signal.NotifyContext(cmd.Context(), syscall.SIGINT, syscall.SIGTERM)
+		defer stop()
+		outcomes = batch.Run(ctx, pending, options, run, func(u batch.Update) {
+			if u.State == batch.StateRunning || u.State.Done() {
+				fmt.Printf("%-8s %s\n", u.State, batchLabel(root, pending[u.Index]))
+			}
+		})
+	}
+
+	return renderBatchOutcomes(root, outcomes)
+}
+
+// selectBatchMeetings shows the pending meetings, all selected by default.
+func selectBatchMeetings(root string, pending []meetings.Meeting) ([]meetings.Meeting, error) {
+	options := make([]huh.Option[int], 0, len(pending))
+	for i, meeting := range pending {
+		options = append(options, huh.NewOption(fmt.Sprintf("%s (%s)", batchLabel(root, meeting), meeting.Transcript), i).Selected(true))
+	}
+
+	var chosen []int
+	err := huh.NewMultiSelect[int]().
+		Title(fmt.Sprintf("Summarize %d pending meeting(s)?", len(pending))).
+		Description("Space toggles, enter confirms").
+		Options(options...).
+		Value(&chosen).
+		Run()
+	if err != nil {
+		return nil, err
+	}
+
+	selected := make([]meetings.Meeting, 0, len(chosen))
+	for _, index := range chosen {
+		selected = append(selected, pending[index])
+	}
+	return selected, nil
+}
+
+// renderBatchOutcomes prints the final table and fails when any meeting did.
+func renderBatchOutcomes(root string, outcomes []batch.Outcome) error {
+	rows := make([][]string, 0, len(outcomes))
+	failed := 0
+	for _, outcome := range outcomes {
+		status := "✅ " + outcome.State.String()
+		detail := filepath.Base(outcome.Result.OutputPath)
+		switch outcome.State {
+		case batch.StateSucceeded:
+			if warnings := outcome.Result.Warnings(); len(warnings) > 0 {
+				detail += fmt.Sprintf(" (%d warning(s))", len(warnings))
+			}
+		default:
+			failed++
+			status = "❌ " + outcome.State.String()
+			detail = firstLine(outcome.Err)
+		}
+
+		duration := ""
+		if outcome.Duration > 0 {
+			duration = outcome.Duration.Round(time.Second).String()
+		}
+		rows = append(rows, []string{batchLabel(root, outcome.Meeting), status, duration, detail})
+	}
+
+	fmt.Println()
+	fmt.Println(ui.RenderTable([]string{"Meeting", "Status", "Time", "Result"}, rows))
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d meeting(s) failed", failed, len(outcomes))
+	}
+	fmt.Println(ui.RenderSuccess(fmt.Sprintf("🎉 Summarized %d meeting(s).", len(outcomes))))
+	return nil
+}
+
+// batchLabel identifies a meeting by its path relative to the batch root.
+func batchLabel(root string, meeting meetings.Meeting) string {
+	if rel, err := filepath.Rel(root, meeting.Dir); err == nil && rel != "." {
+		return rel
+	}
+	return filepath.Base(meeting.Dir)
+}
+
+func firstLine(err error) string {
+	if err == nil {
+		return ""
+	}
+	line, _, _ := strings.Cut(err.Error(), "\n")
+	return line
+}
+
+func init() {
+	rootCmd.AddCommand(batchCmd)
+	batchCmd.Flags().BoolVarP(&batchYes, "yes", "y", false, "Summarize all pending meetings without confirmation")
+	batchCmd.Flags().IntVar(&batchWorkers, "workers", config.DefaultBatchWorkers, "Meetings summarized at once (overrides batch.workers)")
+	batchCmd.Flags().IntVar(&batchRate, "rate", 0, "Maximum runs started per minute, 0 for unlimited (overrides batch.rate_limits)")
+	batchCmd.Flags().StringVar(&batchName, "name", "", "Your name for the summaries; overrides user.name")
+}
@@ -65,7 +65,11 @@ func runCheck(cmd *cobra.Command, args []string) error {
 	if _, err := exec.LookPath("git"); err == nil {
 		fmt.Println(ui.RenderSuccess("✅ Available"))
 	} else {
-		fmt.Println(ui.RenderWarning("⚠️  Not found (optional)"))
+		if config.AppConfig.Git.AutoCommit {
+			fmt.Println(ui.RenderWarning("⚠️  Not found (git.auto_commit is enabled and will be skipped)"))
+		} else {
+			fmt.Println(ui.RenderWarning("⚠️  Not found (optional)"))
+		}
 	}
 
 	fmt.Println()
@@ -0,0 +1,268 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/bashfulrobot/meetsum/config"
+	"github.com/bashfulrobot/meetsum/internal/history"
+	"github.com/bashfulrobot/meetsum/internal/meetings"
+	"github.com/bashfulrobot/meetsum/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// completionShells are the shells completion scripts are generated for.
+var completionShells = []string{"bash", "zsh", "fish", "powershell"}
+
+// installableShells are the shells completion install knows where to put
+// scripts for.
+var installableShells = []string{"bash", "zsh", "fish"}
+
+// completionCmd prints a shell completion script
+var completionCmd = &cobra.Command{
+	Use:   "completion <bash|zsh|fish|powershell>",
+	Short: "Generate the shell completion script",
+	Long: `Print the completion script for a shell. Meeting directory arguments
+complete to customer and meeting folders under paths.file_browser_root_dir,
+with meetings that have a transcript but no summary listed first.
+
+Use "meetsum completion install" to put the script where your shell loads it.`,
+	Args:      cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+	ValidArgs: completionShells,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return writeCompletionScript(cmd.OutOrStdout(), args[0])
+	},
+}
+
+// completionInstallCmd writes the completion script for the user's shell
+var completionInstallCmd = &cobra.Command{
+	Use:   "install [bash|zsh|fish]",
+	Short: "Install shell completion for the current user",
+	Long: `Write the completion script to the standard per-user location for bash,
+zsh or fish. The shell is taken from $SHELL when not given.
+
+  bash  ~/.local/share/bash-completion/completions/meetsum (needs bash-completion)
+  zsh   ~/.zsh/completions/_meetsum (the folder must be on your fpath)
+  fish  ~/.config/fish/completions/meetsum.fish`,
+	Args:      cobra.MatchAll(cobra.MaximumNArgs(1), cobra.OnlyValidArgs),
+	ValidArgs: installableShells,
+	RunE:      runCompletionInstall,
+}
+
+func runCompletionInstall(cmd *cobra.Command, args []string) error {
+	cmd.SilenceUsage = true
+	shell := filepath.Base(os.Getenv("SHELL"))
+	if len(args) > 0 {
+		shell = args[0]
+	}
+
+	path, err := completionInstallPath(shell)
+	if err != nil {
+		return withExitCode(ExitMissingInput, err)
+	}
+
+	var script bytes.Buffer
+	if err := writeCompletionScript(&script, shell); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create %s: %w", filepath.Dir(path), err)
+	}
+	if err := os.WriteFile(path, script.Bytes(), 0644); err != nil {
+		return fmt.Errorf("failed to write completion script: %w", err)
+	}
+
+	fmt.Println(ui.RenderSuccess(fmt.Sprintf("✅ Installed %s completion to %s", shell, path)))
+	switch shell {
+	case "bash":
+		fmt.Println(ui.RenderInfo("Open a new shell to use it. Completion needs the bash-completion package."))
+	case "zsh":
+		fmt.Println(ui.RenderInfo("Add this to ~/.zshrc before compinit if it is not there yet, then open a new shell:"))
+		fmt.Println(ui.FileListStyle.Render(fmt.Sprintf("fpath=(%s $fpath)\nautoload -U compinit && compinit", filepath.Dir(path))))
+	case "fish":
+		fmt.Println(ui.RenderInfo("Open a new shell to use it."))
+	}
+	return nil
+}
+
+// completionInstallPath returns where a shell loads per-user completion
+// scripts from.
+func completionInstallPath(shell string) (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to find home directory: %w", err)
+	}
+
+	switch shell {
+	case "bash":
+		dataHome := os.Getenv("XDG_DATA_HOME")
+		if dataHome == "" {
+			dataHome = filepath.Join(home, ".local", "share")
+		}
+		return filepath.Join(dataHome, "bash-completion", "completions", "meetsum"), nil
+	case "zsh":
+		return filepath.Join(home, ".zsh", "completions", "_meetsum"), nil
+	case "fish":
+		configHome := os.Getenv("XDG_CONFIG_HOME")
+		if configHome == "" {
+			configHome = filepath.Join(home, ".config")
+		}
+		return filepath.Join(configHome, "fish", "completions", "meetsum.fish"), nil
+	case "", ".":
+		return "", fmt.Errorf("could not detect your shell from $SHELL; pass one of: %s", strings.Join(installableShells, ", "))
+	default:
+		return "", fmt.Errorf("cannot install completion for %q; use one of: %s", shell, strings.Join(installableShells, ", "))
+	}
+}
+
+func writeCompletionScript(w io.Writer, shell string) error {
+	switch shell {
+	case "bash":
+		return rootCmd.GenBashCompletionV2(w, true)
+	case "zsh":
+		return rootCmd.GenZshCompletion(w)
+	case "fish":
+		return rootCmd.GenFishCompletion(w, true)
+	case "powershell":
+		return rootCmd.GenPowerShellCompletionWithDesc(w)
+	default:
+		return fmt.Errorf("unsupported shell %q; use one of: %s", shell, strings.Join(completionShells, ", "))
+	}
+}
+
+// completeMeetingDir completes the first argument of commands that take a
+// meeting or root directory.
+func completeMeetingDir(_ *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return completeDirectories(toComplete)
+}
+
+// completeDirFlag completes flags that take a meeting directory.
+func completeDirFlag(_ *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return completeDirectories(toComplete)
+}
+
+// completeMeetingType suggests the configured meeting type names.
+func completeMeetingType(_ *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if config.AppConfig == nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	var names []string
+	for _, name := range config.AppConfig.MeetingTypeNames() {
+		if strings.HasPrefix(name, strings.ToLower(toComplete)) {
+			names = append(names, name)
+		}
+	}
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeDirectories suggests customer and meeting folders under
+// paths.file_browser_root_dir, pending meetings first. Anything typed outside
+// the root falls back to the shell's own directory completion.
+func completeDirectories(toComplete string) ([]string, cobra.ShellCompDirective) {
+	cfg := config.AppConfig
+	if cfg == nil || cfg.Paths.FileBrowserRootDir == "" {
+		return nil, cobra.ShellCompDirectiveFilterDirs
+	}
+	root, err := filepath.Abs(expandPath(cfg.Paths.FileBrowserRootDir))
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveFilterDirs
+	}
+
+	typed := toComplete
+	if typed == "" {
+		typed = root + string(filepath.Separator)
+	}
+	// The completions keep what was typed before the last separator, so
+	// "~/Customers/Ac" completes to "~/Customers/Acme/".
+	typedDir := ""
+	if i := strings.LastIndex(typed, string(filepath.Separator)); i >= 0 {
+		typedDir = typed[:i+1]
+	}
+	prefix := strings.TrimPrefix(typed, typedDir)
+
+	dir, err := filepath.Abs(expandPath(typedDir))
+	if typedDir == "" {
+		dir, err = os.Getwd()
+	}
+	if err != nil || !withinDir(root, dir) {
+		return nil, cobra.ShellCompDirectiveFilterDirs
+	}
+
+	candidates := meetings.Complete(cfg, dir, prefix)
+	if len(candidates) == 0 {
+		return nil, cobra.ShellCompDirectiveFilterDirs
+	}
+
+	directive := cobra.ShellCompDirectiveKeepOrder
+	completions := make([]string, 0, len(candidates))
+	for _, candidate := range candidates {
+		value := typedDir + filepath.Base(candidate.Path)
+		if !candidate.Meeting {
+			// Folders end in a separator so the next Tab continues inside
+			value += string(filepath.Separator)
+			directive |= cobra.ShellCompDirectiveNoSpace
+		}
+		completions = append(completions, value+"\t"+candidateDescription(candidate))
+	}
+	return completions, directive
+}
+
+func candidateDescription(candidate meetings.Candidate) string {
+	switch {
+	case candidate.Meeting && candidate.Pending > 0:
+		return "transcript, no summary"
+	case candidate.Meeting && candidate.Summarized:
+		return "summarized"
+	case candidate.Meeting:
+		return "no single transcript"
+	case candidate.Pending > 0:
+		return fmt.Sprintf("%d pending", candidate.Pending)
+	default:
+		return "folder"
+	}
+}
+
+// withinDir reports whether dir is root or below it.
+func withinDir(root, dir string) bool {
+	rel, err := filepath.Rel(root, dir)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// completeHistoryVersion completes a meeting directory, then version numbers
+// from its history. "current" is offered where allowCurrent is set.
+func completeHistoryVersion(maxArgs int, allowCurrent bool) cobra.CompletionFunc {
+	return func(_ *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 0 {
+			return completeDirectories(toComplete)
+		}
+		if len(args) >= maxArgs {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		versions, err := history.List(expandPath(args[0]))
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		var completions []string
+		if allowCurrent {
+			completions = append(completions, history.Current+"\tfiles in the meeting directory")
+		}
+		for i, version := range versions {
+			completions = append(completions, fmt.Sprintf("%s\t%s, replaced by %s", strconv.Itoa(i+1), version.ID, version.Reason))
+		}
+		return completions, cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveKeepOrder
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(completionCmd)
+	completionCmd.AddCommand(completionInstallCmd)
+}
@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/bashfulrobot/meetsum/config"
+	"github.com/spf13/cobra"
+)
+
+func TestCompleteDirectories(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	for _, name := range []string{"Customers/Acme/2025-10-01/transcript.txt", "Customers/Beta/2025-10-01/notes.md"} {
+		path := filepath.Join(home, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("failed to create %s: %v", filepath.Dir(path), err)
+		}
+		if err := os.WriteFile(path, []byte("t"), 0644); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+	}
+
+	previous := config.AppConfig
+	t.Cleanup(func() { config.AppConfig = previous })
+	config.AppConfig = &config.Config{}
+	config.AppConfig.Paths.FileBrowserRootDir = "~/Customers"
+
+	completions, directive := completeDirectories("~/Customers/")
+	if len(completions) != 2 || completions[0] != "~/Customers/Acme/\t1 pending" || !strings.HasPrefix(completions[1], "~/Customers/Beta/\t") {
+		t.Errorf("unexpected customer completions: %q", completions)
+	}
+	if directive&cobra.ShellCompDirectiveNoSpace == 0 || directive&cobra.ShellCompDirectiveKeepOrder == 0 {
+		t.Errorf("customer folders should keep order without a trailing space, got %v", directive)
+	}
+
+	completions, directive = completeDirectories("~/Customers/Acme/2025")
+	if len(completions) != 1 || completions[0] != "~/Customers/Acme/2025-10-01\ttranscript, no summary" {
+		t.Errorf("unexpected meeting completions: %q", completions)
+	}
+	if directive&cobra.ShellCompDirectiveNoSpace != 0 {
+		t.Errorf("a meeting directory is a complete argument, got %v", directive)
+	}
+
+	if completions, directive := completeDirectories("/etc/"); completions != nil || directive != cobra.ShellCompDirectiveFilterDirs {
+		t.Errorf("paths outside the root should fall back to directory completion, got %q %v", completions, directive)
+	}
+}
+
+func TestCompletionInstallPath(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_DATA_HOME", "")
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(home, "xdg"))
+
+	testCases := map[string]string{
+		"bash": filepath.Join(home, ".local", "share", "bash-completion", "completions", "meetsum"),
+		"zsh":  filepath.Join(home, ".zsh", "completions", "_meetsum"),
+		"fish": filepath.Join(home, "xdg", "fish", "completions", "meetsum.fish"),
+	}
+	for shell, want := range testCases {
+		if got, err := completionInstallPath(shell); err != nil || got != want {
+			t.Errorf("%s: got %q, %v; want %q", shell, got, err, want)
+		}
+	}
+	if _, err := completionInstallPath("tcsh"); err == nil {
+		t.Error("expected an error for an unsupported shell")
+	}
+}
@@ -2,7 +2,9 @@ package cmd
 
 import (
 	"fmt"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/bashfulrobot/meetsum/config"
 	"github.com/bashfulrobot/meetsum/internal/ui"
@@ -14,11 +16,23 @@ var configCmd = &cobra.Command{
 	Use:   "config",
 	Short: "Display current configuration settings",
 	Long: `Display the current configuration settings in a structured table format.
-Shows all configuration values, their defaults, and descriptions.`,
+Shows all configuration values, their defaults, and descriptions, followed by
+the meeting type profiles and what each one overrides.
+
+With --dir, shows every setting in effect for a meeting directory after its
+.meetsum.yaml overrides are merged, and the file each value came from.`,
+	Args: cobra.NoArgs,
 	RunE: runConfig,
 }
 
+var configDir string
+
 func runConfig(cmd *cobra.Command, args []string) error {
+	if configDir != "" {
+		cmd.SilenceUsage = true
+		return showDirConfig(configDir)
+	}
+
 	// Prepare configuration items for display
 	configItems := []ui.ConfigItem{
 		{
@@ -84,10 +98,136 @@ func runConfig(cmd *cobra.Command, args []string) error {
 			Default:     "(not set)",
 			Description: "Default name for first-person perspective summaries",
 		},
+		{
+			Category:    "User",
+			Setting:     "internal_domains",
+			Value:       strings.Join(config.AppConfig.GetInternalDomains(), ", "),
+			Default:     "(not set)",
+			Description: "Your email domains, never used as the invite customer",
+		},
 	}
 
 	// Display the configuration table
-	return ui.ShowConfigurationTable(configItems)
+	if err := ui.ShowConfigurationTable(configItems); err != nil {
+		return err
+	}
+
+	fmt.Println(renderProfiles(config.AppConfig))
+	return nil
+}
+
+// renderProfiles lists the meeting type profiles with the files and settings
+// each one overrides. The default type is listed even when it has no entry.
+func renderProfiles(cfg *config.Config) string {
+	defaultType := cfg.GetDefaultMeetingType()
+	names := cfg.MeetingTypeNames()
+	if _, ok := cfg.GetMeetingType(defaultType); !ok {
+		names = append([]string{defaultType}, names...)
+	}
+
+	customers := make(map[string][]string)
+	for customer, meetingType := range cfg.Meetings.Customers {
+		key := strings.ToLower(strings.TrimSpace(meetingType))
+		customers[key] = append(customers[key], customer)
+	}
+
+	rows := make([][]string, 0, len(names))
+	for _, name := range names {
+		label := name
+		if strings.EqualFold(name, defaultType) {
+			label += " (default)"
+		}
+		extras := "-"
+		if text := cfg.GetPromptExtrasForType(name); text != "" {
+			extras = fmt.Sprintf("%d chars", len(text))
+		}
+		sections := "default"
+		if order := cfg.GetSlackSectionsForType(name); len(order) > 0 {
+			sections = strings.Join(order, ", ")
+		}
+		assigned := customers[strings.ToLower(name)]
+		sort.Strings(assigned)
+		rows = append(rows, []string{
+			label,
+			cfg.GetInstructionsPathForType(name),
+			cfg.GetFilenameTemplateForType(name),
+			sections,
+			orDash(cfg.GetWritingSkillPathForType(name)),
+			extras,
+			orDash(strings.Join(assigned, ", ")),
+		})
+	}
+
+	return ui.RenderTable([]string{"Profile", "Instructions", "Filename", "Slack Sections", "Writing Skill", "Extras", "Customers"}, rows)
+}
+
+// showDirConfig prints the effective settings for a meeting directory.
+func showDirConfig(dir string) error {
+	meetingDir, err := resolveMeetingDir(dir)
+	if err != nil {
+		return withExitCode(ExitMissingInput, err)
+	}
+	dirConfig, err := config.AppConfig.ForDir(meetingDir)
+	if err != nil {
+		return withExitCode(ExitValidation, err)
+	}
+
+	keys := make([]string, 0, len(dirConfig.Values))
+	for key := range dirConfig.Values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	rows := make([][]string, 0, len(keys))
+	for _, key := range keys {
+		source, overridden := dirConfig.Sources[key]
+		if !overridden {
+			source = config.SettingsFileSource(key)
+		}
+		if source == "" {
+			source = ui.SecondaryStyle.Render("default")
+		}
+		rows = append(rows, []string{key, settingValue(key, dirConfig.Values[key]), source})
+	}
+
+	fmt.Println(ui.RenderHeader("⚙️  Effective Configuration", meetingDir))
+	if len(dirConfig.Files) == 0 {
+		fmt.Println(ui.RenderInfo(fmt.Sprintf("No %s overrides apply; showing the global settings.", config.DirConfigFile)))
+	}
+	for _, file := range dirConfig.Files {
+		fmt.Println(ui.RenderInfo(fmt.Sprintf("📄 Overrides: %s", file)))
+	}
+	fmt.Println(ui.RenderTable([]string{"Setting", "Value", "Source"}, rows))
+	return nil
+}
+
+// settingValue formats an effective setting for display. Secrets and AI
+// arguments are not echoed, matching the configuration overview.
+func settingValue(key string, value any) string {
+	var items []string
+	switch typed := value.(type) {
+	case nil:
+	case []any:
+		for _, item := range typed {
+			items = append(items, fmt.Sprint(item))
+		}
+	case []string:
+		items = typed
+	default:
+		items = []string{fmt.Sprint(typed)}
+	}
+	text := strings.Join(items, ", ")
+
+	name := key[strings.LastIndex(key, ".")+1:]
+	switch {
+	case key == "ai.args":
+		return aiArgsSummary(items)
+	case text == "":
+		return ui.SecondaryStyle.Render("(empty)")
+	case strings.Contains(name, "token") || strings.Contains(name, "secret") || name == "webhook_url":
+		return "(set)"
+	}
+	return text
 }
 
 func aiArgsSummary(args []string) string {
@@ -96,4 +236,6 @@ func aiArgsSummary(args []string) string {
 
 func init() {
 	rootCmd.AddCommand(configCmd)
+	configCmd.Flags().StringVar(&configDir, "dir", "", "Show the effective settings for a meeting directory")
+	_ = configCmd.RegisterFlagCompletionFunc("dir", completeDirFlag)
 }
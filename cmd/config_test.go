@@ -0,0 +1,25 @@
+package cmd
+
+import "testing"
+
+func TestSettingValue(t *testing.T) {
+	tests := []struct {
+		key   string
+		value any
+		want  string
+	}{
+		{"ai.command", "claude", "claude"},
+		{"ai.args", []any{"-p", "--model"}, "2 configured"},
+		{"ai.args", []any{}, "0 configured"},
+		{"notes.tags", []any{"meeting", "meetsum"}, "meeting, meetsum"},
+		{"slack.bot_token", "xoxb-secret", "(set)"},
+		{"webhooks.secret", "s3cret", "(set)"},
+		{"slack.customers.acme.webhook_url", "https://hooks.slack.com/x", "(set)"},
+		{"batch.workers", 2, "2"},
+	}
+	for _, tt := range tests {
+		if got := settingValue(tt.key, tt.value); got != tt.want {
+			t.Errorf("settingValue(%q, %v) = %q, want %q", tt.key, tt.value, got, tt.want)
+		}
+	}
+}
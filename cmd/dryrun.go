@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/bashfulrobot/meetsum/internal/app"
+	"github.com/bashfulrobot/meetsum/internal/summary"
+	"github.com/bashfulrobot/meetsum/internal/ui"
+)
+
+// showDryRun prints the size breakdown of the prompt Run would send and then
+// prints the prompt, or writes it to --prompt-out. Nothing else is written.
+func showDryRun(out io.Writer, session *app.Session, report *runReport) error {
+	prompt, err := session.Prompt()
+	if err != nil {
+		fmt.Fprintln(out, ui.RenderError(err.Error()))
+		return withExitCode(ExitValidation, err)
+	}
+	report.DryRun = true
+	report.Prompt = &prompt
+
+	rows := make([][]string, 0, len(prompt.Parts)+1)
+	for _, part := range prompt.Parts {
+		rows = append(rows, []string{part.Name, strconv.Itoa(part.Size), "~" + strconv.Itoa(part.Tokens)})
+	}
+	rows = append(rows, []string{"total", strconv.Itoa(prompt.Size), "~" + strconv.Itoa(prompt.Tokens)})
+
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, ui.RenderInfo("🔍 Dry run: the AI command was not called and no files were changed"))
+	fmt.Fprintln(out, ui.RenderTable([]string{"Prompt part", "Bytes", "Tokens"}, rows))
+	fmt.Fprintln(out, ui.SecondaryStyle.Render("Token counts are estimates (about 4 bytes per token)."))
+
+	if promptOut != "" {
+		path := expandPath(promptOut)
+		if err := os.WriteFile(path, []byte(prompt.Text), 0600); err != nil {
+			return fmt.Errorf("failed to write prompt: %w", err)
+		}
+		report.PromptPath = path
+		fmt.Fprintln(out, ui.RenderSuccess(fmt.Sprintf("Prompt written to %s", path)))
+		return nil
+	}
+
+	if outputFormat == outputJSON {
+		report.PromptText = prompt.Text
+		return nil
+	}
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, ui.SecondaryStyle.Render("──────── prompt ────────"))
+	fmt.Fprintln(out, prompt.Text)
+	fmt.Fprintln(out, ui.SecondaryStyle.Render("──────── end of prompt ────────"))
+	return nil
+}
+
+// dryRunReport holds the prompt details added to the JSON report.
+type dryRunReport struct {
+	DryRun     bool            `json:"dry_run,omitempty"`
+	Prompt     *summary.Prompt `json:"prompt,omitempty"`
+	PromptPath string          `json:"prompt_path,omitempty"`
+	PromptText string          `json:"prompt_text,omitempty"`
+}
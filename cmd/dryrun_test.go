@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/bashfulrobot/meetsum/config"
+)
+
+func TestGenerateSummaryDryRun(t *testing.T) {
+	previous := config.AppConfig
+	t.Cleanup(func() {
+		config.AppConfig = previous
+		nonInteractive, dryRun, promptOut, meetingDir, userNameFlag = false, false, "", "", ""
+	})
+
+	cfg := newValidationTestConfig(t)
+	cfg.AI.Command = "no-such-ai-command"
+	config.AppConfig = cfg
+	nonInteractive, dryRun, userNameFlag = true, true, "Tester"
+	promptOut = filepath.Join(t.TempDir(), "prompt.txt")
+
+	dir := filepath.Join(t.TempDir(), "Acme", "2026-02-04")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatalf("failed to create meeting dir: %v", err)
+	}
+	writeFile(t, filepath.Join(dir, "transcript.txt"), "the transcript")
+
+	report := &runReport{}
+	if err := generateSummary(io.Discard, []string{dir}, report); err != nil {
+		t.Fatalf("dry run failed: %v", err)
+	}
+
+	written, err := os.ReadFile(promptOut)
+	if err != nil {
+		t.Fatalf("expected the prompt file: %v", err)
+	}
+	if !report.DryRun || report.Prompt == nil || string(written) != report.Prompt.Text || report.PromptPath != promptOut {
+		t.Errorf("unexpected dry-run report %+v", report)
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil || len(entries) != 1 {
+		t.Errorf("dry run must not change the meeting directory, found %v", entries)
+	}
+}
@@ -0,0 +1,217 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bashfulrobot/meetsum/config"
+	"github.com/bashfulrobot/meetsum/internal/app"
+	"github.com/bashfulrobot/meetsum/internal/history"
+	"github.com/bashfulrobot/meetsum/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var historyDiffSlack bool
+
+// historyCmd lists the archived versions of a meeting's summary
+var historyCmd = &cobra.Command{
+	Use:   "history <meeting_directory>",
+	Short: "List, compare and restore previous versions of a summary",
+	Long: `Every time meetsum overwrites a summary (a new run, a regenerated section, a
+rebuilt Slack summary or a restore), the previous summary and Slack summary
+are archived in the meeting's .meetsum/history folder together with when they
+were replaced and which AI provider produced them.
+
+Versions are referred to by their number in the list (1 is the most recent),
+their ID, or "current" for the files in the meeting directory:
+  meetsum history ~/Customers/Acme/2025-10-01
+  meetsum history diff ~/Customers/Acme/2025-10-01 2 1
+  meetsum history diff ~/Customers/Acme/2025-10-01 1 --slack
+  meetsum history restore ~/Customers/Acme/2025-10-01 2`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeMeetingDir,
+	RunE:              runHistory,
+}
+
+// historyDiffCmd shows a colored diff between two versions
+var historyDiffCmd = &cobra.Command{
+	Use:   "diff <meeting_directory> <from> [to]",
+	Short: "Show a colored diff between two versions of a summary",
+	Long: `Compare two versions of the summary. "to" defaults to current, so
+"meetsum history diff DIR 1" shows what changed in the latest overwrite.
+Use --slack to compare the Slack summaries instead.`,
+	Args:              cobra.RangeArgs(2, 3),
+	ValidArgsFunction: completeHistoryVersion(3, true),
+	RunE:              runHistoryDiff,
+}
+
+// historyRestoreCmd brings back an archived version
+var historyRestoreCmd = &cobra.Command{
+	Use:   "restore <meeting_directory> <version>",
+	Short: "Restore an archived version of a summary",
+	Long: `Copy an archived summary and Slack summary back into the meeting directory.
+The files being replaced are archived first, so a restore can be undone.`,
+	Args:              cobra.ExactArgs(2),
+	ValidArgsFunction: completeHistoryVersion(2, false),
+	RunE:              runHistoryRestore,
+}
+
+func runHistory(cmd *cobra.Command, args []string) error {
+	cmd.SilenceUsage = true
+	meetingDir, err := resolveMeetingDir(args[0])
+	if err != nil {
+		return withExitCode(ExitMissingInput, err)
+	}
+
+	versions, err := history.List(meetingDir)
+	if err != nil {
+		return err
+	}
+	if len(versions) == 0 {
+		fmt.Println(ui.RenderInfo(fmt.Sprintf("No archived versions in %s", meetingDir)))
+		return nil
+	}
+
+	rows := make([][]string, 0, len(versions)+1)
+	if current := history.LoadGeneration(meetingDir); current.Source != "" || current.Provider != "" {
+		rows = append(rows, []string{history.Current, "", formatHistoryTime(current.GeneratedAt), orDash(current.Provider), orDash(current.Source), ""})
+	}
+	for i, version := range versions {
+		rows = append(rows, []string{
+			strconv.Itoa(i + 1),
+			version.ID,
+			formatHistoryTime(version.GeneratedAt),
+			orDash(version.Provider),
+			orDash(version.Source),
+			fmt.Sprintf("%s by %s", formatHistoryTime(version.ArchivedAt), version.Reason),
+		})
+	}
+
+	fmt.Println(ui.RenderTable([]string{"#", "Version", "Generated", "Provider", "Source", "Replaced"}, rows))
+	fmt.Println(ui.SecondaryStyle.Render("Compare with: meetsum history diff DIR <from> [to] · Restore with: meetsum history restore DIR <version>"))
+	return nil
+}
+
+func runHistoryDiff(cmd *cobra.Command, args []string) error {
+	cmd.SilenceUsage = true
+	meetingDir, err := resolveMeetingDir(args[0])
+	if err != nil {
+		return withExitCode(ExitMissingInput, err)
+	}
+	to := history.Current
+	if len(args) == 3 {
+		to = args[2]
+	}
+
+	runtimeService := app.NewService(config.AppConfig, logger)
+	fromLabel, fromText, err := historyVersionText(runtimeService, meetingDir, args[1])
+	if err != nil {
+		return withExitCode(ExitMissingInput, err)
+	}
+	toLabel, toText, err := historyVersionText(runtimeService, meetingDir, to)
+	if err != nil {
+		return withExitCode(ExitMissingInput, err)
+	}
+
+	diff := history.Diff(fromLabel, toLabel, fromText, toText)
+	if diff == "" {
+		fmt.Println(ui.RenderInfo(fmt.Sprintf("%s and %s are identical", fromLabel, toLabel)))
+		return nil
+	}
+	fmt.Println(ui.RenderDiff(diff))
+	return nil
+}
+
+// historyVersionText reads the summary, or Slack summary with --slack, of a
+// version reference. A missing file reads as empty so additions and removals
+// still diff.
+func historyVersionText(service *app.Service, meetingDir, ref string) (string, string, error) {
+	var path, label string
+	if strings.EqualFold(strings.TrimSpace(ref), history.Current) {
+		outputs, err := service.OutputPaths(meetingDir)
+		if err != nil {
+			return "", "", err
+		}
+		path, label = outputs.Summary, history.Current
+		if historyDiffSlack {
+			path = outputs.Slack
+		}
+	} else {
+		version, err := history.Find(meetingDir, ref)
+		if err != nil {
+			return "", "", err
+		}
+		path, label = version.SummaryPath(), version.ID
+		if historyDiffSlack {
+			path = version.SlackPath()
+		}
+	}
+	if path == "" {
+		return label, "", nil
+	}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return label, "", nil
+	}
+	if err != nil {
+		return "", "", err
+	}
+	return label + "/" + filepath.Base(path), string(data), nil
+}
+
+func runHistoryRestore(cmd *cobra.Command, args []string) error {
+	cmd.SilenceUsage = true
+	meetingDir, err := resolveMeetingDir(args[0])
+	if err != nil {
+		return withExitCode(ExitMissingInput, err)
+	}
+
+	runtimeService := app.NewService(config.AppConfig, logger)
+	result, err := runtimeService.RestoreVersion(meetingDir, args[1])
+	if err != nil {
+		fmt.Println(ui.RenderError(fmt.Sprintf("Failed to restore version: %v", err)))
+		return withExitCode(ExitMissingInput, err)
+	}
+
+	infoLines := []string{
+		fmt.Sprintf("⏪ Restored version: %s", result.Restored.ID),
+		fmt.Sprintf("📄 Summary file: %s", filepath.Base(result.Outputs.Summary)),
+	}
+	if result.Restored.Slack != "" {
+		infoLines = append(infoLines, fmt.Sprintf("📋 Slack summary: %s", filepath.Base(result.Outputs.Slack)))
+	}
+	if result.Archived.ID != "" {
+		infoLines = append(infoLines, fmt.Sprintf("🗄️  Replaced files archived as: %s", result.Archived.ID))
+	}
+	fmt.Println(ui.RenderInfoBox(infoLines...))
+	fmt.Println(ui.RenderSuccess("🎉 Version restored."))
+	return nil
+}
+
+// formatHistoryTime shows a timestamp in local time, or a dash when unknown.
+func formatHistoryTime(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+	return t.Local().Format("2006-01-02 15:04")
+}
+
+func orDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
+
+func init() {
+	rootCmd.AddCommand(historyCmd)
+	historyCmd.AddCommand(historyDiffCmd)
+	historyCmd.AddCommand(historyRestoreCmd)
+
+	historyDiffCmd.Flags().BoolVar(&historyDiffSlack, "slack", false, "Compare the Slack summaries instead of the main summaries")
+}
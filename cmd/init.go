@@ -0,0 +1,318 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/bashfulrobot/meetsum/config"
+	"github.com/bashfulrobot/meetsum/internal/setup"
+	"github.com/bashfulrobot/meetsum/internal/ui"
+	"github.com/charmbracelet/huh"
+	"github.com/spf13/cobra"
+)
+
+// customProvider is the provider choice for an AI CLI meetsum does not know.
+const customProvider = "custom"
+
+// initCmd walks through creating settings.yaml
+var initCmd = &cobra.Command{
+	Use:   "init",
+	Short: "Create settings.yaml with a guided setup",
+	Long: `Walk through the settings every install needs: your name, the customer
+folder root, the automation directory, the AI command (installed gemini,
+claude, codex and ollama CLIs are detected), writing skills and logging.
+
+Paths are checked as you enter them. When the automation directory has no
+instructions file yet, a starter one is created from the bundled sample. The
+config is written with comments to ~/.config/meetsum/settings.yaml (or the
+--config path). An existing file is updated in place: only the settings asked
+about change, every other section and comment is kept, and the previous file
+is saved as settings.yaml.bak.`,
+	Args: cobra.NoArgs,
+	RunE: runInit,
+}
+
+func runInit(cmd *cobra.Command, args []string) error {
+	cmd.SilenceUsage = true
+	if !stdinIsTerminal() {
+		return withExitCode(ExitMissingInput, fmt.Errorf("meetsum init needs an interactive terminal; copy samples/settings.sample.yaml instead"))
+	}
+
+	path, err := initConfigPath()
+	if err != nil {
+		return err
+	}
+	existing, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	// Refuse up front rather than after the questions when the file cannot be updated
+	if _, err := setup.Update(existing, setup.Settings{}); err != nil {
+		return withExitCode(ExitValidation, fmt.Errorf("cannot update %s: %w; fix or move it, then run init again", path, err))
+	}
+
+	fmt.Println(ui.RenderHeader("🛠️  meetsum setup", "Answers are checked as you go; nothing is written until the end"))
+
+	settings := initialSettings()
+	if err := askPaths(&settings); err != nil {
+		return err
+	}
+	if err := askAI(&settings); err != nil {
+		return err
+	}
+	if err := askSkillsAndLogging(&settings); err != nil {
+		return err
+	}
+
+	confirmTitle := fmt.Sprintf("Write settings to %s?", path)
+	if len(existing) > 0 {
+		confirmTitle = fmt.Sprintf("Update %s? Other settings are kept and the current file is saved as settings.yaml.bak", path)
+	}
+	confirmed := true
+	if err := huh.NewConfirm().Title(confirmTitle).Value(&confirmed).Run(); err != nil {
+		return err
+	}
+	if !confirmed {
+		fmt.Println(ui.RenderWarning("Setup cancelled; nothing was written."))
+		return nil
+	}
+
+	instructionsPath, created, err := setup.ScaffoldInstructions(settings.AutomationDir, settings.InstructionsFile)
+	if err != nil {
+		return err
+	}
+	if settings.LogOutput != "screen" {
+		if err := os.MkdirAll(filepath.Dir(setup.ExpandHome(settings.LogFile)), 0755); err != nil {
+			return fmt.Errorf("failed to create log directory: %w", err)
+		}
+	}
+	content, err := setup.Update(existing, settings)
+	if err != nil {
+		return err
+	}
+	backup, err := setup.WriteConfig(path, string(content))
+	if err != nil {
+		return err
+	}
+
+	infoLines := []string{fmt.Sprintf("⚙️  Settings: %s", path)}
+	if backup != "" {
+		infoLines = append(infoLines, fmt.Sprintf("🗄️  Previous settings: %s", backup))
+	}
+	if created {
+		infoLines = append(infoLines, fmt.Sprintf("📝 Starter instructions: %s", instructionsPath))
+	} else {
+		infoLines = append(infoLines, fmt.Sprintf("📝 Instructions (kept): %s", instructionsPath))
+	}
+	infoLines = append(infoLines, fmt.Sprintf("🤖 AI command: %s", strings.Join(append([]string{settings.AICommand}, settings.AIArgs...), " ")))
+	fmt.Println(ui.RenderInfoBox(infoLines...))
+	fmt.Println(ui.RenderSuccess("🎉 meetsum is set up."))
+	fmt.Println(ui.RenderInfo("Next: run 'meetsum check' to verify dependencies, then 'meetsum <meeting_directory>'."))
+	return nil
+}
+
+// initConfigPath returns where init writes settings.yaml.
+func initConfigPath() (string, error) {
+	if cfgFile != "" {
+		return filepath.Abs(expandPath(cfgFile))
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to find home directory: %w", err)
+	}
+	return filepath.Join(home, ".config", "meetsum", "settings.yaml"), nil
+}
+
+// initialSettings prefills the wizard from the loaded configuration, so
+// running init again edits the current settings. Skill paths are only kept
+// when the file exists.
+func initialSettings() setup.Settings {
+	cfg := config.AppConfig
+	if cfg == nil {
+		cfg = &config.Config{}
+	}
+	settings := setup.Settings{
+		UserName:         cfg.User.Name,
+		RootDir:          cfg.Paths.FileBrowserRootDir,
+		AutomationDir:    cfg.Paths.AutomationDir,
+		InstructionsFile: cfg.Paths.InstructionsFile,
+		AICommand:        cfg.AI.Command,
+		AIArgs:           cfg.AI.Args,
+		LogLevel:         cfg.Logging.Level,
+		LogOutput:        cfg.Logging.Output,
+		LogFile:          cfg.Logging.File,
+	}
+	if setup.CheckOptionalFile(cfg.Skills.WritingStyle) == nil {
+		settings.WritingStyle = cfg.Skills.WritingStyle
+	}
+	if setup.CheckOptionalFile(cfg.Skills.Humanizer) == nil {
+		settings.Humanizer = cfg.Skills.Humanizer
+	}
+	if settings.InstructionsFile == "" {
+		settings.InstructionsFile = "Meeting-summary-llm-instructions.md"
+	}
+	if settings.LogLevel == "" {
+		settings.LogLevel = "info"
+	}
+	if settings.LogOutput == "" {
+		settings.LogOutput = "screen"
+	}
+	return settings
+}
+
+func askPaths(settings *setup.Settings) error {
+	return huh.NewForm(
+		huh.NewGroup(
+			huh.NewInput().
+				Title("Your Name").
+				Description("Summaries are written from your first-person perspective").
+				Value(&settings.UserName).
+				Validate(func(s string) error {
+					if strings.TrimSpace(s) == "" {
+						return fmt.Errorf("name is required")
+					}
+					return nil
+				}),
+			huh.NewInput().
+				Title("Customer Root Directory").
+				Description("Holds one folder per customer, each with dated meeting folders").
+				Placeholder("~/Documents/Company/Customers").
+				Value(&settings.RootDir).
+				Validate(setup.CheckExistingDir),
+			huh.NewInput().
+				Title("Automation Directory").
+				Description("Where the LLM instructions live; created if missing").
+				Value(&settings.AutomationDir).
+				Validate(setup.CheckCreatableDir),
+			huh.NewInput().
+				Title("Instructions File").
+				Description("A starter file is created from the bundled sample if none exists").
+				Value(&settings.InstructionsFile).
+				Validate(setup.CheckFileName),
+		),
+	).Run()
+}
+
+func askAI(settings *setup.Settings) error {
+	detected := setup.DetectProviders()
+	installed := make(map[string]bool, len(detected))
+	for _, provider := range detected {
+		installed[provider.Name] = true
+	}
+
+	choice := customProvider
+	options := make([]huh.Option[string], 0, len(setup.Providers)+1)
+	for _, provider := range setup.Providers {
+		status := "not found in PATH"
+		if installed[provider.Name] {
+			status = "installed"
+		}
+		options = append(options, huh.NewOption(fmt.Sprintf("%s · %s (%s)", provider.Name, provider.Description, status), provider.Name))
+		if provider.Command == settings.AICommand {
+			choice = provider.Name
+		}
+	}
+	// Suggest an installed CLI over a configured one that is missing
+	if len(detected) > 0 && ((choice == customProvider && settings.AICommand == "") || (choice != customProvider && !installed[choice])) {
+		choice = detected[0].Name
+	}
+	options = append(options, huh.NewOption("other · any AI CLI that reads the prompt on stdin", customProvider))
+
+	err := huh.NewSelect[string]().
+		Title("AI Command").
+		Description(fmt.Sprintf("Detected %d of %d known AI CLIs", len(detected), len(setup.Providers))).
+		Options(options...).
+		Value(&choice).
+		Validate(func(name string) error {
+			if name != customProvider && !installed[name] {
+				return fmt.Errorf("%s is not in your PATH; install it or pick another", name)
+			}
+			return nil
+		}).
+		Run()
+	if err != nil {
+		return err
+	}
+
+	for _, provider := range setup.Providers {
+		if provider.Name == choice && provider.Command != settings.AICommand {
+			settings.AICommand = provider.Command
+			settings.AIArgs = provider.Args
+		}
+	}
+	args := strings.Join(settings.AIArgs, " ")
+
+	var fields []huh.Field
+	if choice == customProvider {
+		fields = append(fields, huh.NewInput().
+			Title("Command").
+			Description("Executable name in PATH or an absolute path").
+			Value(&settings.AICommand).
+			Validate(func(s string) error {
+				if _, err := exec.LookPath(strings.TrimSpace(s)); err != nil {
+					return fmt.Errorf("%q was not found in PATH", strings.TrimSpace(s))
+				}
+				return nil
+			}))
+	}
+	fields = append(fields, huh.NewInput().
+		Title("Arguments").
+		Description("Space separated; edit settings.yaml for arguments that contain spaces").
+		Value(&args))
+	if err := huh.NewForm(huh.NewGroup(fields...)).Run(); err != nil {
+		return err
+	}
+
+	settings.AICommand = strings.TrimSpace(settings.AICommand)
+	settings.AIArgs = strings.Fields(args)
+	return nil
+}
+
+func askSkillsAndLogging(settings *setup.Settings) error {
+	levels := []string{"debug", "info", "warn", "error"}
+	outputs := []string{"screen", "file", "both"}
+
+	return huh.NewForm(
+		huh.NewGroup(
+			huh.NewInput().
+				Title("Writing-Style Skill (optional)").
+				Description("Skill file trained on your writing voice; leave empty to skip").
+				Placeholder("~/.claude/skills/writing-style/writing-style.md").
+				Value(&settings.WritingStyle).
+				Validate(setup.CheckOptionalFile),
+			huh.NewInput().
+				Title("Humanizer Skill (optional)").
+				Description("Used when there is no writing-style skill; leave empty to skip").
+				Placeholder("~/.claude/skills/humanizer/humanizer.md").
+				Value(&settings.Humanizer).
+				Validate(setup.CheckOptionalFile),
+		).Title("Writing Skills"),
+		huh.NewGroup(
+			huh.NewSelect[string]().
+				Title("Log Level").
+				Options(huh.NewOptions(levels...)...).
+				Value(&settings.LogLevel),
+			huh.NewSelect[string]().
+				Title("Log Output").
+				Description("screen shows errors in the terminal; file keeps them in the log file only").
+				Options(huh.NewOptions(outputs...)...).
+				Value(&settings.LogOutput),
+			huh.NewInput().
+				Title("Log File").
+				Value(&settings.LogFile).
+				Validate(func(s string) error {
+					if strings.TrimSpace(s) == "" {
+						return fmt.Errorf("a log file path is required")
+					}
+					return setup.CheckCreatableDir(filepath.Dir(setup.ExpandHome(s)))
+				}),
+		).Title("Logging"),
+	).Run()
+}
+
+func init() {
+	rootCmd.AddCommand(initCmd)
+}
@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/bashfulrobot/meetsum/internal/summary"
+	"github.com/bashfulrobot/meetsum/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var lintFix bool
+
+// lintCmd checks a summary file against the Slack formatting rules
+var lintCmd = &cobra.Command{
+	Use:   "lint FILE",
+	Short: "Check a summary file for Slack formatting problems",
+	Long: `Check a summary file against the Slack mrkdwn rules from the LLM instructions:
+*text* bold (never **text**), "-" bullets, ALL CAPS section headers, blank lines
+around headers and a single trailing newline. Headers are the title, the known
+sections (*HIGHLIGHTS*, *ACTION ITEMS*, *RISKS*, *MEETING RECORDING*, *FULL
+MEETING SUMMARY*) and ALL CAPS _TOPIC_ lines; other emphasized lines are left
+alone. Missing required sections and
+action items without an assignee are reported too.
+
+Use --fix to rewrite the file with all mechanical fixes applied. The command
+exits non-zero while issues remain.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runLint,
+}
+
+func runLint(cmd *cobra.Command, args []string) error {
+	path := expandPath(args[0])
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", args[0], err)
+	}
+	content := string(data)
+
+	issues := summary.LintSummary(content)
+	if lintFix {
+		fixed, remaining := summary.FixSummary(content)
+		if fixed != content {
+			if err := os.WriteFile(path, []byte(fixed), 0644); err != nil {
+				return fmt.Errorf("failed to write %s: %w", args[0], err)
+			}
+			fmt.Println(ui.RenderSuccess(fmt.Sprintf("Fixed %d issue(s) in %s", len(issues)-len(remaining), args[0])))
+		}
+		issues = remaining
+	}
+
+	if len(issues) == 0 {
+		fmt.Println(ui.RenderSuccess(fmt.Sprintf("%s follows the summary formatting rules", args[0])))
+		return nil
+	}
+
+	fixable := 0
+	for _, issue := range issues {
+		if issue.Fixable {
+			fixable++
+			fmt.Println(ui.RenderWarning(issue.String()))
+		} else {
+			fmt.Println(ui.RenderError(issue.String()))
+		}
+	}
+
+	fmt.Println()
+	if fixable > 0 {
+		fmt.Println(ui.RenderInfo(fmt.Sprintf("💡 %d issue(s) can be fixed automatically with --fix", fixable)))
+	}
+
+	cmd.SilenceUsage = true
+	return fmt.Errorf("%d formatting issue(s) found", len(issues))
+}
+
+func init() {
+	rootCmd.AddCommand(lintCmd)
+	lintCmd.Flags().BoolVar(&lintFix, "fix", false, "Rewrite the file with mechanical fixes applied")
+}
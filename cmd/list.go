@@ -0,0 +1,149 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/bashfulrobot/meetsum/config"
+	"github.com/bashfulrobot/meetsum/internal/meetings"
+	"github.com/bashfulrobot/meetsum/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	listJSON     bool
+	listCustomer string
+	listSince    string
+	listSort     string
+	listReverse  bool
+	listPending  bool
+)
+
+// listCmd shows every meeting directory with its summary status
+var listCmd = &cobra.Command{
+	Use:   "list [root_directory]",
+	Short: "List meetings and whether they have been summarized",
+	Long: `Scan paths.file_browser_root_dir (or the given directory) and show every
+meeting directory with its customer, date, and which files are present:
+transcript, summary, Slack summary and whether the transcript was renamed.
+
+Filter with --customer (case-insensitive substring) and --since (a date such
+as 2025-10-01 or a period such as 14d, 2w or 36h). Sort with --sort customer,
+date or modified. --json prints the full records for scripts.`,
+	Args:              cobra.MaximumNArgs(1),
+	ValidArgsFunction: completeMeetingDir,
+	RunE:              runList,
+}
+
+func runList(cmd *cobra.Command, args []string) error {
+	cfg := config.AppConfig
+	cmd.SilenceUsage = true
+
+	root := cfg.Paths.FileBrowserRootDir
+	if len(args) > 0 {
+		root = args[0]
+	}
+	if root == "" {
+		return withExitCode(ExitMissingInput, fmt.Errorf("no directory to scan: pass one or set paths.file_browser_root_dir"))
+	}
+	root, err := resolveMeetingDir(root)
+	if err != nil {
+		return withExitCode(ExitMissingInput, err)
+	}
+
+	since, err := meetings.ParseSince(listSince, time.Now())
+	if err != nil {
+		return withExitCode(ExitMissingInput, err)
+	}
+
+	found, err := meetings.Scan(cfg, root)
+	if err != nil {
+		return err
+	}
+	found = meetings.Filter{Customer: listCustomer, Since: since}.Apply(found)
+	if listPending {
+		pending := found[:0]
+		for _, meeting := range found {
+			if meeting.Pending() {
+				pending = append(pending, meeting)
+			}
+		}
+		found = pending
+	}
+	if err := meetings.SortBy(found, listSort, listReverse); err != nil {
+		return withExitCode(ExitMissingInput, err)
+	}
+
+	if listJSON {
+		if found == nil {
+			found = []meetings.Meeting{}
+		}
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(found)
+	}
+
+	if len(found) == 0 {
+		fmt.Println(ui.RenderInfo(fmt.Sprintf("No meetings found under %s", root)))
+		return nil
+	}
+
+	rows := make([][]string, 0, len(found))
+	summarized, ambiguous := 0, false
+	for _, meeting := range found {
+		if meeting.HasSummary() {
+			summarized++
+		}
+		transcript := listMark(meeting.TranscriptPresent)
+		if meeting.TranscriptPresent && meeting.Transcript == "" {
+			transcript = "⚠️"
+			ambiguous = true
+		}
+		date := meeting.Date
+		if date == "" {
+			date = "-"
+		}
+		rows = append(rows, []string{
+			meeting.Customer,
+			date,
+			transcript,
+			listMark(meeting.HasSummary()),
+			listMark(meeting.SlackSummary != ""),
+			listMark(meeting.Renamed),
+			meeting.LastModified.Format("2006-01-02 15:04"),
+		})
+	}
+
+	fmt.Println(ui.RenderTable([]string{"Customer", "Date", "Transcript", "Summary", "Slack", "Renamed", "Last Modified"}, rows))
+	footer := fmt.Sprintf("%d meeting(s), %d summarized", len(found), summarized)
+	if ambiguous {
+		footer += " · ⚠️  = several transcript candidates"
+	}
+	fmt.Println(ui.SecondaryStyle.Render(footer))
+	for _, meeting := range found {
+		if meeting.ConfigError != "" {
+			fmt.Println(ui.RenderWarning(fmt.Sprintf("%s: %s", meeting.Dir, meeting.ConfigError)))
+		}
+	}
+	return nil
+}
+
+func listMark(present bool) string {
+	if present {
+		return "✅"
+	}
+	return "—"
+}
+
+func init() {
+	rootCmd.AddCommand(listCmd)
+	listCmd.Flags().BoolVar(&listJSON, "json", false, "Print meetings as JSON")
+	listCmd.Flags().StringVar(&listCustomer, "customer", "", "Only show customers whose name contains this text")
+	listCmd.Flags().StringVar(&listSince, "since", "", "Only show meetings on or after a date (YYYY-MM-DD) or within a period (14d, 2w, 36h)")
+	listCmd.Flags().StringVar(&listSort, "sort", meetings.SortCustomer, "Sort by "+strings.Join(meetings.SortKeys, ", "))
+	listCmd.Flags().BoolVar(&listReverse, "reverse", false, "Reverse the sort order")
+	listCmd.Flags().BoolVar(&listPending, "pending", false, "Only show meetings with a transcript but no summary")
+}
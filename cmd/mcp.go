@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/bashfulrobot/meetsum/config"
+	"github.com/bashfulrobot/meetsum/internal/app"
+	"github.com/bashfulrobot/meetsum/internal/mcp"
+	"github.com/spf13/cobra"
+)
+
+// mcpCmd runs meetsum as a Model Context Protocol server on stdio
+var mcpCmd = &cobra.Command{
+	Use:   "mcp",
+	Short: "Run a stdio MCP server so AI assistants can drive meetsum",
+	Long: `Run a Model Context Protocol server over stdin/stdout exposing these tools:
+
+  summarize_meeting     generate the summary for a meeting directory
+  list_meetings         list meeting directories and their summary status
+  get_summary           read a meeting's summary or Slack mini summary
+  validate_meeting_dir  check a meeting directory is ready to summarize
+
+Register it with your assistant as a stdio server whose command is
+"meetsum mcp". Logs go to stderr or the configured log file; stdout carries
+only protocol messages.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		ctx, stop := signal.NotifyContext(cmd.Context(), syscall.SIGINT, syscall.SIGTERM)
+		defer stop()
+
+		service := app.NewService(config.AppConfig, logger)
+		server := mcp.NewServer("meetsum", version, mcp.Tools(config.AppConfig, service), logger)
+		return server.Serve(ctx, os.Stdin, os.Stdout)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(mcpCmd)
+}
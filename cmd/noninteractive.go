@@ -0,0 +1,128 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+
+	"github.com/bashfulrobot/meetsum/internal/app"
+	"github.com/mattn/go-isatty"
+)
+
+// Exit codes returned by meetsum so scripts and CI jobs can tell failures apart.
+const (
+	ExitOK           = 0
+	ExitFailure      = 1
+	ExitMissingInput = 2
+	ExitPreflight    = 3
+	ExitAI           = 4
+	ExitValidation   = 5
+)
+
+// Output formats for the summary command.
+const (
+	outputText = "text"
+	outputJSON = "json"
+)
+
+// exitError carries the process exit code for err.
+type exitError struct {
+	code int
+	err  error
+}
+
+func (e *exitError) Error() string { return e.err.Error() }
+
+func (e *exitError) Unwrap() error { return e.err }
+
+// withExitCode attaches an exit code to err. A nil err stays nil.
+func withExitCode(code int, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &exitError{code: code, err: err}
+}
+
+// ExitCode returns the process exit code for an error returned by Execute.
+func ExitCode(err error) int {
+	if err == nil {
+		return ExitOK
+	}
+	var coded *exitError
+	if errors.As(err, &coded) {
+		return coded.code
+	}
+	return ExitFailure
+}
+
+// runErrorCode classifies an error returned by Session.Run.
+func runErrorCode(err error) int {
+	switch {
+	case errors.Is(err, app.ErrGeneration):
+		return ExitAI
+	case errors.Is(err, app.ErrInvalidSummary):
+		return ExitValidation
+	default:
+		return ExitFailure
+	}
+}
+
+// stdinIsTerminal reports whether prompts can be shown to a user.
+func stdinIsTerminal() bool {
+	fd := os.Stdin.Fd()
+	return isatty.IsTerminal(fd) || isatty.IsCygwinTerminal(fd)
+}
+
+// runReport is the machine-readable result printed by --output json.
+type runReport struct {
+	Status            string   `json:"status"`
+	ExitCode          int      `json:"exit_code"`
+	Error             string   `json:"error,omitempty"`
+	Provider          string   `json:"provider,omitempty"`
+	MeetingDir        string   `json:"meeting_dir,omitempty"`
+	MeetingType       string   `json:"meeting_type,omitempty"`
+	Transcript        string   `json:"transcript,omitempty"`
+	ConfigFiles       []string `json:"config_files,omitempty"`
+	SummaryPath       string   `json:"summary_path,omitempty"`
+	SlackSummaryPath  string   `json:"slack_summary_path,omitempty"`
+	RenamedTranscript string   `json:"renamed_transcript,omitempty"`
+	EmailPath         string   `json:"email_path,omitempty"`
+	NotePath          string   `json:"note_path,omitempty"`
+	SlackPermalink    string   `json:"slack_permalink,omitempty"`
+	RecordingURL      string   `json:"recording_url,omitempty"`
+	GitCommit         string   `json:"git_commit,omitempty"`
+	LintIssues        []string `json:"lint_issues,omitempty"`
+	Warnings          []string `json:"warnings,omitempty"`
+	dryRunReport
+}
+
+// setResult copies the outputs of a successful run into the report.
+func (r *runReport) setResult(result app.RunResult) {
+	r.SummaryPath = result.OutputPath
+	r.SlackSummaryPath = result.SlackOutputPath
+	r.RenamedTranscript = result.RenamedTranscript
+	r.EmailPath = result.EmailOutputPath
+	r.NotePath = result.NoteOutputPath
+	r.SlackPermalink = result.SlackPermalink
+	r.RecordingURL = result.RecordingURL
+	r.GitCommit = result.GitCommit
+	for _, issue := range result.LintIssues {
+		r.LintIssues = append(r.LintIssues, issue.String())
+	}
+	r.Warnings = result.Warnings()
+}
+
+// finish records the outcome and writes the report as indented JSON.
+func (r *runReport) finish(w io.Writer, err error) error {
+	r.Status = "succeeded"
+	r.ExitCode = ExitCode(err)
+	if err != nil {
+		r.Status = "failed"
+		r.Error = err.Error()
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(r)
+}
@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/bashfulrobot/meetsum/config"
+	"github.com/spf13/cobra"
+)
+
+func TestGenerateSummaryNonInteractiveExitCodes(t *testing.T) {
+	commandDir := t.TempDir()
+	scripts := map[string]string{
+		"fake-ai-ok":      "#!/usr/bin/env bash\ncat >/dev/null\nprintf '*_2026-02-04 ACME CADENCE CALL SUMMARY_*\\n\\n*HIGHLIGHTS*\\n\\n- Shipped it.\\n'\n",
+		"fake-ai-fail":    "#!/usr/bin/env bash\ncat >/dev/null\necho boom >&2\nexit 1\n",
+		"fake-ai-invalid": "#!/usr/bin/env bash\ncat >/dev/null\necho 'Loaded cached credentials.'\n",
+	}
+	for name, script := range scripts {
+		if err := os.WriteFile(filepath.Join(commandDir, name), []byte(script), 0755); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+	}
+	t.Setenv("PATH", commandDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	previous := config.AppConfig
+	t.Cleanup(func() {
+		config.AppConfig = previous
+		nonInteractive, meetingDir, userNameFlag = false, "", ""
+	})
+	nonInteractive = true
+
+	testCases := []struct {
+		name     string
+		command  string
+		userName string
+		dir      bool
+		override string
+		wantCode int
+	}{
+		{name: "missing name", command: "fake-ai-ok", dir: true, wantCode: ExitMissingInput},
+		{name: "missing directory", command: "fake-ai-ok", userName: "Tester", wantCode: ExitMissingInput},
+		{name: "missing AI command", command: "no-such-ai-command", userName: "Tester", dir: true, wantCode: ExitPreflight},
+		{name: "AI failure", command: "fake-ai-fail", userName: "Tester", dir: true, wantCode: ExitAI},
+		{name: "invalid output", command: "fake-ai-invalid", userName: "Tester", dir: true, wantCode: ExitValidation},
+		{name: "success", command: "fake-ai-ok", userName: "Tester", dir: true, wantCode: ExitOK},
+		{name: "directory override command", command: "no-such-ai-command", userName: "Tester", dir: true, override: "fake-ai-ok", wantCode: ExitOK},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := newValidationTestConfig(t)
+			cfg.AI.Command = tc.command
+			config.AppConfig = cfg
+			userNameFlag = tc.userName
+
+			meetingDir = ""
+			var args []string
+			if tc.dir {
+				dir := filepath.Join(t.TempDir(), "Acme", "2026-02-04")
+				if err := os.MkdirAll(dir, 0755); err != nil {
+					t.Fatalf("failed to create meeting dir: %v", err)
+				}
+				writeFile(t, filepath.Join(dir, "transcript.txt"), "transcript")
+				if tc.override != "" {
+					writeFile(t, filepath.Join(dir, config.DirConfigFile), "ai:\n  command: "+tc.override+"\n")
+				}
+				args = []string{dir}
+			}
+
+			report := &runReport{}
+			err := generateSummary(io.Discard, args, report)
+			if got := ExitCode(err); got != tc.wantCode {
+				t.Fatalf("expected exit code %d, got %d (%v)", tc.wantCode, got, err)
+			}
+
+			var buf bytes.Buffer
+			if err := report.finish(&buf, err); err != nil {
+				t.Fatalf("failed to write report: %v", err)
+			}
+			var decoded runReport
+			if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+				t.Fatalf("report is not valid JSON: %v\n%s", err, buf.String())
+			}
+			if decoded.ExitCode != tc.wantCode {
+				t.Errorf("expected report exit code %d, got %d", tc.wantCode, decoded.ExitCode)
+			}
+			if tc.override != "" && decoded.Provider != tc.override {
+				t.Errorf("expected the override's provider %q, got %q", tc.override, decoded.Provider)
+			}
+			if tc.wantCode == ExitOK && (decoded.Status != "succeeded" || decoded.SummaryPath == "" || decoded.Transcript != "transcript.txt") {
+				t.Errorf("unexpected success report %+v", decoded)
+			}
+			if tc.wantCode != ExitOK && (decoded.Status != "failed" || decoded.Error == "") {
+				t.Errorf("unexpected failure report %+v", decoded)
+			}
+		})
+	}
+}
+
+func TestTypeAndProfileAreExclusive(t *testing.T) {
+	for _, command := range []*cobra.Command{rootCmd, regenerateCmd} {
+		t.Cleanup(func() {
+			for _, name := range []string{"type", "profile"} {
+				flag := command.Flags().Lookup(name)
+				_ = flag.Value.Set("")
+				flag.Changed = false
+			}
+		})
+
+		if err := command.ParseFlags([]string{"--type", "qbr", "--profile", "discovery"}); err != nil {
+			t.Fatalf("%s: failed to parse flags: %v", command.Name(), err)
+		}
+		if err := command.ValidateFlagGroups(); err == nil {
+			t.Errorf("%s: expected --type and --profile to be rejected together", command.Name())
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/bashfulrobot/meetsum/config"
+	"github.com/bashfulrobot/meetsum/internal/app"
+	"github.com/bashfulrobot/meetsum/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	regenerateSection string
+	regenerateName    string
+	regenerateType    string
+)
+
+// regenerateCmd rewrites one section of an existing summary
+var regenerateCmd = &cobra.Command{
+	Use:   "regenerate <meeting_directory>",
+	Short: "Rewrite one section of an existing summary",
+	Long: `Ask the AI to rewrite a single section of the saved summary, using the
+summary and transcript as context. The new section replaces the old one under
+the same header, every other section is left untouched, and the Slack summary
+is rebuilt.
+
+Sections use the same names as the summary headers, for example:
+  meetsum regenerate ~/Customers/Acme/2025-10-01 --section action-items
+  meetsum regenerate ~/Customers/Acme/2025-10-01 --section highlights
+  meetsum regenerate ~/Customers/Acme/2025-10-01 --section "topic:product roadmap"`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeMeetingDir,
+	RunE:              runRegenerate,
+}
+
+func runRegenerate(cmd *cobra.Command, args []string) error {
+	cmd.SilenceUsage = true
+	if strings.TrimSpace(regenerateSection) == "" {
+		return withExitCode(ExitMissingInput, fmt.Errorf("--section is required (e.g. action-items, highlights, risks)"))
+	}
+
+	meetingDir, err := resolveMeetingDir(args[0])
+	if err != nil {
+		return withExitCode(ExitMissingInput, err)
+	}
+
+	runtimeService := app.NewService(config.AppConfig, logger)
+	aiCommand, err := runtimeService.PreflightDir(meetingDir)
+	if err != nil {
+		fmt.Println(ui.RenderError(err.Error()))
+		return withExitCode(ExitPreflight, err)
+	}
+
+	interactive := stdinIsTerminal()
+	userName, err := resolveUserName(regenerateName, interactive)
+	if err != nil {
+		return err
+	}
+
+	request := app.RegenerateRequest{
+		UserName:    userName,
+		MeetingDir:  meetingDir,
+		MeetingType: regenerateType,
+		Section:     regenerateSection,
+	}
+
+	message := fmt.Sprintf("🧠 %s is rewriting the %s section...", aiCommand, regenerateSection)
+	var result app.RegenerateResult
+	if config.AppConfig.Features.TraceMode || !interactive {
+		fmt.Println(message)
+		result, err = runtimeService.RegenerateSection(request)
+	} else {
+		var value any
+		value, err = ui.RunWithSpinner(message, func() (any, error) {
+			return runtimeService.RegenerateSection(request)
+		})
+		if typed, ok := value.(app.RegenerateResult); ok {
+			result = typed
+		}
+	}
+	if err != nil {
+		fmt.Println(ui.RenderError(fmt.Sprintf("Failed to regenerate section: %v", err)))
+		return withExitCode(runErrorCode(err), err)
+	}
+
+	fmt.Println()
+	fmt.Println(result.Content)
+	fmt.Println()
+
+	infoLines := []string{
+		fmt.Sprintf("📄 Summary file: %s", filepath.Base(result.OutputPath)),
+		fmt.Sprintf("🔁 Section: %s", result.Section),
+	}
+	if result.SlackOutputPath != "" {
+		infoLines = append(infoLines, fmt.Sprintf("📋 Slack summary: %s", filepath.Base(result.SlackOutputPath)))
+	}
+	if result.RecordingURL != "" {
+		infoLines = append(infoLines, fmt.Sprintf("🎥 Recording: %s", result.RecordingURL))
+	}
+	fmt.Println(ui.RenderInfoBox(infoLines...))
+	if result.SlackWarning != "" {
+		fmt.Println(ui.RenderWarning(fmt.Sprintf("Could not save Slack summary: %s", result.SlackWarning)))
+	}
+	if result.RecordingWarning != "" {
+		fmt.Println(ui.RenderWarning(result.RecordingWarning))
+	}
+	if len(result.LintIssues) > 0 {
+		fmt.Println(ui.RenderWarning(fmt.Sprintf("Summary has %d formatting issue(s) to review:", len(result.LintIssues))))
+		for _, issue := range result.LintIssues {
+			fmt.Println(ui.FileListStyle.Render("  " + issue.String()))
+		}
+	}
+
+	fmt.Println(ui.RenderSuccess("🎉 Section regenerated."))
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(regenerateCmd)
+	regenerateCmd.Flags().StringVarP(&regenerateSection, "section", "s", "", "Section to rewrite (action-items, highlights, risks, topic:NAME)")
+	regenerateCmd.Flags().StringVar(&regenerateName, "name", "", "Your name for the summary; overrides user.name")
+	regenerateCmd.Flags().StringVar(&regenerateType, "type", "", "Meeting type, when it differs from the meeting type file")
+	regenerateCmd.Flags().StringVar(&regenerateType, "profile", "", "Meeting type profile to use; same as --type")
+	_ = regenerateCmd.RegisterFlagCompletionFunc("type", completeMeetingType)
+	_ = regenerateCmd.RegisterFlagCompletionFunc("profile", completeMeetingType)
+	regenerateCmd.MarkFlagsMutuallyExclusive("type", "profile")
+}
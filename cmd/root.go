@@ -18,11 +18,25 @@ import (
 )
 
 var (
-	traceMode  bool
-	askName    bool
-	meetingDir string
-	cfgFile    string
-	logger     *log.Logger
+	traceMode    bool
+	askName      bool
+	writeEmail   bool
+	meetingType  string
+	recordingURL string
+	meetingDir   string
+	userNameFlag string
+	outputFormat string
+	cfgFile      string
+
+	// flagTraceMode and flagWriteEmail are set when --trace or --email is
+	// passed, so they can be applied after .meetsum.yaml overrides
+	flagTraceMode  *bool
+	flagWriteEmail *bool
+
+	nonInteractive bool
+	dryRun         bool
+	promptOut      string
+	logger         *log.Logger
 
 	// Version information
 	version   = "dev"
@@ -36,8 +50,9 @@ var rootCmd = &cobra.Command{
 	Short: "Meeting Summary Generator CLI Tool",
 	Long: `A CLI tool that generates structured meeting summaries using AI.
 Reads meeting transcripts and generates formatted summaries with context.`,
-	Args: cobra.MaximumNArgs(1),
-	RunE: runMeetSum,
+	Args:              cobra.MaximumNArgs(1),
+	ValidArgsFunction: completeMeetingDir,
+	RunE:              runMeetSum,
 }
 
 // SetVersion sets the version information for the application.
@@ -60,16 +75,32 @@ func init() {
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.config/meetsum/settings.yaml)")
 	rootCmd.Flags().BoolVar(&traceMode, "trace", false, "Run without spinners to see all output")
 	rootCmd.Flags().BoolVar(&askName, "ask-name", false, "Prompt for name even if default is configured")
+	rootCmd.Flags().BoolVar(&writeEmail, "email", false, "Also generate a customer follow-up email draft (.eml)")
+	rootCmd.Flags().StringVar(&meetingType, "type", "", "Meeting type (e.g. discovery, qbr); overrides the meeting type file and customer default")
+	rootCmd.Flags().StringVar(&meetingType, "profile", "", "Meeting type profile to use; same as --type")
+	rootCmd.Flags().StringVar(&recordingURL, "recording-url", "", "Recording link to substitute for the summary's placeholder")
+	rootCmd.Flags().BoolVar(&nonInteractive, "non-interactive", false, "Never prompt; require name and directory from flags or config (default when stdin is not a terminal)")
+	rootCmd.Flags().StringVar(&userNameFlag, "name", "", "Your name for the summary; overrides user.name")
+	rootCmd.Flags().StringVar(&meetingDir, "dir", "", "Meeting directory (alternative to the positional argument)")
+	_ = rootCmd.RegisterFlagCompletionFunc("dir", completeDirFlag)
+	_ = rootCmd.RegisterFlagCompletionFunc("type", completeMeetingType)
+	_ = rootCmd.RegisterFlagCompletionFunc("profile", completeMeetingType)
+	// --type and --profile share a variable, so only one may be given
+	rootCmd.MarkFlagsMutuallyExclusive("type", "profile")
+	rootCmd.Flags().StringVarP(&outputFormat, "output", "o", outputText, "Result format: text or json")
+	rootCmd.Flags().BoolVar(&dryRun, "dry-run", false, "Show the exact prompt and its size without calling the AI or changing files")
+	rootCmd.Flags().StringVar(&promptOut, "prompt-out", "", "With --dry-run, write the prompt to this file instead of printing it")
 }
 
 func initConfig() {
 	if cfgFile != "" {
-		// Use config file from the flag
-		fmt.Printf("Using config file: %s\n", cfgFile)
+		// Use config file from the flag. Printed to stderr so stdout stays
+		// clean for machine-readable commands such as mcp.
+		fmt.Fprintf(os.Stderr, "Using config file: %s\n", cfgFile)
 	}
 
 	if err := config.LoadConfig(); err != nil {
-		fmt.Printf("Error loading config: %v\n", err)
+		fmt.Fprintf(os.Stderr, "Error loading config: %v\n", err)
 		// Note: cobra.OnInitialize functions can't return errors,
 		// but we can't exit here either. This is a limitation we need to address.
 		// For now, we'll continue with defaults but log the error.
@@ -130,93 +161,162 @@ func openLogFile(path string) io.Writer {
 }
 
 func runMeetSum(cmd *cobra.Command, args []string) error {
-	// Override trace mode from config if flag is set
+	// Flags override the config, including .meetsum.yaml files, when set
+	flagTraceMode, flagWriteEmail = nil, nil
 	if cmd.Flags().Changed("trace") {
-		config.AppConfig.Features.TraceMode = traceMode
+		flagTraceMode = &traceMode
 	}
+	if cmd.Flags().Changed("email") {
+		flagWriteEmail = &writeEmail
+	}
+
+	// Never wait on prompts nobody can answer (cron, CI, pipes)
+	if !cmd.Flags().Changed("non-interactive") && !stdinIsTerminal() {
+		nonInteractive = true
+	}
+	if nonInteractive {
+		cmd.SilenceUsage = true
+	}
+
+	if outputFormat != outputText && outputFormat != outputJSON {
+		return withExitCode(ExitMissingInput, fmt.Errorf("unknown output format %q (use %s or %s)", outputFormat, outputText, outputJSON))
+	}
+	if outputFormat == outputText {
+		return generateSummary(os.Stdout, args, &runReport{})
+	}
+
+	// Keep stdout for the JSON document; progress goes to stderr
+	report := &runReport{}
+	err := generateSummary(os.Stderr, args, report)
+	if reportErr := report.finish(os.Stdout, err); reportErr != nil && err == nil {
+		return reportErr
+	}
+	return err
+}
+
+// generateSummary runs the summary pipeline, printing progress to out and
+// recording what it did in report. Returned errors carry an exit code.
+func generateSummary(out io.Writer, args []string, report *runReport) error {
+	// Get meeting directory
+	if len(args) > 0 {
+		meetingDir = args[0]
+	}
+	if nonInteractive && strings.TrimSpace(meetingDir) == "" {
+		return withExitCode(ExitMissingInput, fmt.Errorf("meeting directory is required in non-interactive mode: pass it as an argument or with --dir"))
+	}
+
+	var err error
+	meetingDir, err = getMeetingDirectory()
+	if err != nil {
+		if nonInteractive {
+			return withExitCode(ExitMissingInput, err)
+		}
+		return err
+	}
+	report.MeetingDir = meetingDir
 
+	// Check the AI command the meeting's .meetsum.yaml overrides select
 	runtimeService := app.NewService(config.AppConfig, logger)
-	aiCommand, err := runtimeService.Preflight()
+	aiCommand, err := runtimeService.PreflightDir(meetingDir)
+	report.Provider = aiCommand
+	if err != nil && dryRun {
+		// A dry run never calls the AI, so a missing command is only a warning
+		fmt.Fprintln(out, ui.RenderWarning(fmt.Sprintf("Preflight: %v", err)))
+		err = nil
+	}
 	if err != nil {
-		fmt.Println(ui.RenderHeader("🤖 Meeting Summary Generator", "Runtime Preflight"))
-		fmt.Println(ui.RenderError(err.Error()))
-		fmt.Println()
-		fmt.Println(ui.RenderInfo("💡 Next steps:"))
+		fmt.Fprintln(out, ui.RenderHeader("🤖 Meeting Summary Generator", "Runtime Preflight"))
+		fmt.Fprintln(out, ui.RenderError(err.Error()))
+		fmt.Fprintln(out)
+		fmt.Fprintln(out, ui.RenderInfo("💡 Next steps:"))
 		for _, line := range preflightGuidance(aiCommand) {
-			fmt.Println(ui.RenderInfo("  • " + line))
+			fmt.Fprintln(out, ui.RenderInfo("  • "+line))
 		}
-		return err
+		return withExitCode(ExitPreflight, err)
 	}
 
 	// Display header
-	fmt.Println(ui.RenderHeader("🤖 Meeting Summary Generator", fmt.Sprintf("Powered by %s", aiCommand)))
+	fmt.Fprintln(out, ui.RenderHeader("🤖 Meeting Summary Generator", fmt.Sprintf("Powered by %s", aiCommand)))
 
-	// Get user's name - use config default unless --ask-name is set
+	// Get user's name - use --name or the config default unless --ask-name is set
 	var userName string
 	defaultName := strings.TrimSpace(config.AppConfig.User.Name)
-	if defaultName != "" && !askName {
+	if flagName := strings.TrimSpace(userNameFlag); flagName != "" {
+		defaultName = flagName
+	}
+	switch {
+	case defaultName != "" && (!askName || nonInteractive):
 		userName = defaultName
-		fmt.Println(ui.RenderInfo(fmt.Sprintf("👤 Using configured name: %s", userName)))
-	} else {
+		fmt.Fprintln(out, ui.RenderInfo(fmt.Sprintf("👤 Using configured name: %s", userName)))
+	case nonInteractive:
+		return withExitCode(ExitMissingInput, fmt.Errorf("user name is required in non-interactive mode: pass --name or set user.name"))
+	default:
 		userName, err = getUserName()
 		if err != nil {
 			return err
 		}
 	}
 
-	// Get meeting directory
-	if len(args) > 0 {
-		meetingDir = args[0]
-	}
-
-	meetingDir, err = getMeetingDirectory()
-	if err != nil {
-		return err
-	}
-
 	session, err := runtimeService.Prepare(app.RunRequest{
-		UserName:   userName,
-		MeetingDir: meetingDir,
+		UserName:     userName,
+		MeetingDir:   meetingDir,
+		MeetingType:  meetingType,
+		RecordingURL: recordingURL,
+		TraceMode:    flagTraceMode,
+		WriteEmail:   flagWriteEmail,
 	})
 	if err != nil {
-		fmt.Println(ui.RenderError(err.Error()))
-		return err
+		fmt.Fprintln(out, ui.RenderError(err.Error()))
+		return withExitCode(ExitValidation, err)
 	}
 	preparation := session.Preparation()
+	report.MeetingType = preparation.MeetingType
+	report.Transcript = preparation.TranscriptFile
+	report.ConfigFiles = preparation.ConfigFiles
 
 	// Show summary of found files
-	fmt.Println(ui.RenderInfoBox(
+	foundLines := []string{
 		fmt.Sprintf("📁 Meeting Directory: %s", filepath.Base(preparation.MeetingDir)),
+		fmt.Sprintf("🏷️  Meeting Type: %s", preparation.MeetingType),
 		fmt.Sprintf("📄 Transcript: ✅ %s", preparation.TranscriptFile),
 		"📋 Instructions: ✅ Found",
-	))
+	}
+	for _, file := range preparation.ConfigFiles {
+		foundLines = append(foundLines, fmt.Sprintf("⚙️  Overrides: %s", file))
+	}
+	fmt.Fprintln(out, ui.RenderInfoBox(foundLines...))
 
 	// Check for optional files
 	if len(preparation.OptionalFiles) > 0 {
-		fmt.Println(ui.RenderSuccess("🎯 Context files found:"))
+		fmt.Fprintln(out, ui.RenderSuccess("🎯 Context files found:"))
 		for _, file := range preparation.OptionalFiles {
-			fmt.Println(ui.FileListStyle.Render("  " + file))
+			fmt.Fprintln(out, ui.FileListStyle.Render("  "+file))
 		}
 	} else {
-		fmt.Println(ui.RenderWarning("No context files found (pov-input.md)"))
+		fmt.Fprintln(out, ui.RenderWarning("No context files found (pov-input.md)"))
+	}
+
+	if dryRun {
+		return showDryRun(out, session, report)
 	}
 
 	// Show processing info
-	_, resolvedArgs, resolveErr := ai.ResolveConfiguredInvocation(config.AppConfig.AI.Command, config.AppConfig.AI.Args)
+	_, resolvedArgs, resolveErr := ai.ResolveConfiguredInvocation(session.Config().AI.Command, session.Config().AI.Args)
 	if resolveErr != nil {
-		return resolveErr
+		return withExitCode(ExitPreflight, resolveErr)
 	}
 
-	fmt.Println()
-	fmt.Printf("🤖 Runtime Command: %s\n", aiCommand)
-	fmt.Printf("🧩 Runtime Args: %d configured token(s)\n", len(resolvedArgs))
-	fmt.Printf("📍 Working Directory: %s\n", preparation.MeetingDir)
-	fmt.Println("⚡ Starting summary generation...")
-	fmt.Println()
+	fmt.Fprintln(out)
+	fmt.Fprintf(out, "🤖 Runtime Command: %s\n", aiCommand)
+	fmt.Fprintf(out, "🧩 Runtime Args: %d configured token(s)\n", len(resolvedArgs))
+	fmt.Fprintf(out, "📍 Working Directory: %s\n", preparation.MeetingDir)
+	fmt.Fprintln(out, "⚡ Starting summary generation...")
+	fmt.Fprintln(out)
 
+	// The spinner needs a terminal and owns stdout, so scripts never get it
 	var runResult app.RunResult
-	if config.AppConfig.Features.TraceMode {
-		fmt.Printf("🧠 %s is processing your meeting transcript...\n", aiCommand)
+	if session.Config().Features.TraceMode || nonInteractive || outputFormat == outputJSON {
+		fmt.Fprintf(out, "🧠 %s is processing your meeting transcript...\n", aiCommand)
 		runResult, err = session.Run()
 	} else {
 		result, err := ui.RunWithSpinner(
@@ -226,7 +326,7 @@ func runMeetSum(cmd *cobra.Command, args []string) error {
 			},
 		)
 		if err != nil {
-			return err
+			return withExitCode(runErrorCode(err), err)
 		}
 
 		typedResult, ok := result.(app.RunResult)
@@ -237,12 +337,13 @@ func runMeetSum(cmd *cobra.Command, args []string) error {
 	}
 
 	if err != nil {
-		fmt.Println(ui.RenderError(fmt.Sprintf("Failed to generate summary: %v", err)))
+		fmt.Fprintln(out, ui.RenderError(fmt.Sprintf("Failed to generate summary: %v", err)))
 		if config.AppConfig.Logging.Output == "file" || config.AppConfig.Logging.Output == "both" {
-			fmt.Println(ui.RenderInfo(fmt.Sprintf("💡 Check the log file for detailed error output: %s", config.AppConfig.GetLogFilePath())))
+			fmt.Fprintln(out, ui.RenderInfo(fmt.Sprintf("💡 Check the log file for detailed error output: %s", config.AppConfig.GetLogFilePath())))
 		}
-		return err
+		return withExitCode(runErrorCode(err), err)
 	}
+	report.setResult(runResult)
 
 	// Show success message
 	infoLines := []string{
@@ -255,16 +356,34 @@ func runMeetSum(cmd *cobra.Command, args []string) error {
 	if runResult.RenamedTranscript != "" {
 		infoLines = append(infoLines, fmt.Sprintf("📝 Transcript renamed to: %s", runResult.RenamedTranscript))
 	}
-	fmt.Println(ui.RenderInfoBox(infoLines...))
-	if runResult.RenameWarning != "" {
-		fmt.Println(ui.RenderWarning(fmt.Sprintf("Could not rename transcript: %s", runResult.RenameWarning)))
+	if runResult.EmailOutputPath != "" {
+		infoLines = append(infoLines, fmt.Sprintf("✉️  Follow-up email draft: %s", filepath.Base(runResult.EmailOutputPath)))
+	}
+	if runResult.RecordingURL != "" {
+		infoLines = append(infoLines, fmt.Sprintf("🎥 Recording: %s", runResult.RecordingURL))
+	}
+	if runResult.GitCommit != "" {
+		infoLines = append(infoLines, fmt.Sprintf("🔖 Git commit: %s", runResult.GitCommit))
 	}
-	if runResult.SlackWarning != "" {
-		fmt.Println(ui.RenderWarning(fmt.Sprintf("Could not save Slack summary: %s", runResult.SlackWarning)))
+	if runResult.NoteOutputPath != "" {
+		infoLines = append(infoLines, fmt.Sprintf("🗒️  Note: %s", runResult.NoteOutputPath))
+	}
+	if runResult.SlackPermalink != "" {
+		infoLines = append(infoLines, fmt.Sprintf("💬 Posted to Slack: %s", runResult.SlackPermalink))
+	}
+	fmt.Fprintln(out, ui.RenderInfoBox(infoLines...))
+	for _, warning := range runResult.Warnings() {
+		fmt.Fprintln(out, ui.RenderWarning(warning))
+	}
+	if len(runResult.LintIssues) > 0 {
+		fmt.Fprintln(out, ui.RenderWarning(fmt.Sprintf("Summary has %d formatting issue(s) to review:", len(runResult.LintIssues))))
+		for _, issue := range runResult.LintIssues {
+			fmt.Fprintln(out, ui.FileListStyle.Render("  "+issue.String()))
+		}
 	}
 
-	fmt.Println()
-	fmt.Println(ui.RenderSuccess("🎉 All done! Your meeting summary is ready."))
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, ui.RenderSuccess("🎉 All done! Your meeting summary is ready."))
 
 	return nil
 }
@@ -298,6 +417,21 @@ func preflightGuidance(aiCommand string) []string {
 	return lines
 }
 
+// resolveUserName picks the name from a flag, then user.name, and prompts
+// only when a terminal is available.
+func resolveUserName(flagValue string, interactive bool) (string, error) {
+	if name := strings.TrimSpace(flagValue); name != "" {
+		return name, nil
+	}
+	if name := strings.TrimSpace(config.AppConfig.User.Name); name != "" {
+		return name, nil
+	}
+	if !interactive {
+		return "", withExitCode(ExitMissingInput, fmt.Errorf("user name is required: pass --name or set user.name"))
+	}
+	return getUserName()
+}
+
 func getUserName() (string, error) {
 	fmt.Println(ui.RenderInfo("👤 Enter your name (for first-person perspective):"))
 
@@ -323,20 +457,7 @@ func getUserName() (string, error) {
 
 func getMeetingDirectory() (string, error) {
 	if meetingDir != "" {
-		// Expand ~ to home directory
-		expandedDir := expandPath(meetingDir)
-
-		// Validate provided directory
-		if _, err := os.Stat(expandedDir); os.IsNotExist(err) {
-			return "", fmt.Errorf("directory '%s' does not exist", meetingDir)
-		}
-
-		// Convert to absolute path for proper metadata extraction
-		absPath, err := filepath.Abs(expandedDir)
-		if err != nil {
-			return "", fmt.Errorf("failed to resolve absolute path: %w", err)
-		}
-		return absPath, nil
+		return resolveMeetingDir(meetingDir)
 	}
 
 	// If file browser is enabled, go directly to file picker
@@ -392,6 +513,24 @@ func getMeetingDirectory() (string, error) {
 	return absPath, nil
 }
 
+// resolveMeetingDir expands, validates and absolutizes a meeting directory argument.
+func resolveMeetingDir(dir string) (string, error) {
+	// Expand ~ to home directory
+	expandedDir := expandPath(dir)
+
+	// Validate provided directory
+	if _, err := os.Stat(expandedDir); os.IsNotExist(err) {
+		return "", fmt.Errorf("directory '%s' does not exist", dir)
+	}
+
+	// Convert to absolute path for proper metadata extraction
+	absPath, err := filepath.Abs(expandedDir)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve absolute path: %w", err)
+	}
+	return absPath, nil
+}
+
 // expandPath expands ~ to the user's home directory
 func expandPath(path string) string {
 	if strings.HasPrefix(path, "~/") {
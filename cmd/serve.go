@@ -0,0 +1,189 @@
+package cmd
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/bashfulrobot/meetsum/config"
+	"github.com/bashfulrobot/meetsum/internal/app"
+	"github.com/bashfulrobot/meetsum/internal/server"
+	"github.com/bashfulrobot/meetsum/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	serveAddress     string
+	serveToken       string
+	serveConcurrency int
+	serveQueueSize   int
+	serveNoToken     bool
+)
+
+// serveShutdownTimeout bounds how long in-flight HTTP requests get on shutdown.
+const serveShutdownTimeout = 10 * time.Second
+
+// serveCmd exposes the summary runtime over a local HTTP API
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Run a local HTTP API for scripts and editor integrations",
+	Long: `Start an HTTP server that queues summary runs for meeting directories.
+
+Endpoints:
+  POST /v1/jobs                    submit {"meeting_dir": "/abs/path", "user_name", "meeting_type", "recording_url"}
+  GET  /v1/jobs?limit=N            list recent jobs, newest first
+  GET  /v1/jobs/{id}               poll a job's status and result
+  GET  /v1/jobs/{id}/summary       fetch the generated summary
+  GET  /v1/jobs/{id}/slack-summary fetch the Slack mini summary
+  GET  /healthz                    liveness check (no token required)
+
+Jobs run on --concurrency workers; submissions beyond --queue-size waiting
+jobs are rejected with 503. Requests must send "Authorization: Bearer <token>"
+with the token from server.token, MEETSUM_SERVER_TOKEN or --token; when none is
+set a random token is generated and printed at startup. --no-token serves
+requests without one, but only on a loopback address and only when they are
+addressed to localhost or a loopback IP. Job history is kept in memory only.`,
+	Args: cobra.NoArgs,
+	RunE: runServe,
+}
+
+func runServe(cmd *cobra.Command, _ []string) error {
+	cfg := config.AppConfig
+	address := cfg.Server.Address
+	if cmd.Flags().Changed("addr") {
+		address = serveAddress
+	}
+	if strings.TrimSpace(address) == "" {
+		address = config.DefaultServerAddress
+	}
+	token := cfg.GetServerToken()
+	if cmd.Flags().Changed("token") {
+		token = strings.TrimSpace(serveToken)
+	}
+	concurrency := cfg.Server.Concurrency
+	if cmd.Flags().Changed("concurrency") {
+		concurrency = serveConcurrency
+	}
+	queueSize := cfg.Server.QueueSize
+	if cmd.Flags().Changed("queue-size") {
+		queueSize = serveQueueSize
+	}
+
+	if serveNoToken {
+		if !isLoopbackAddress(address) {
+			return fmt.Errorf("refusing to listen on %s without a token; drop --no-token or bind to 127.0.0.1", address)
+		}
+		token = ""
+	}
+	generated := token == "" && !serveNoToken
+	if generated {
+		var err error
+		if token, err = generateServeToken(); err != nil {
+			return err
+		}
+	}
+
+	runtimeService := app.NewService(cfg, logger)
+	// Each submission is checked again with its .meetsum.yaml overrides,
+	// which may select another command
+	aiCommand, err := runtimeService.Preflight()
+	if err != nil {
+		fmt.Println(ui.RenderWarning(fmt.Sprintf("Preflight: %v; only meetings whose .meetsum.yaml selects another command can be summarized", err)))
+	}
+
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", address, err)
+	}
+
+	queue := server.NewQueue(concurrency, queueSize, cfg.Server.History, logger)
+	api := server.New(runtimeService, queue, server.Options{
+		Token:    token,
+		UserName: strings.TrimSpace(cfg.User.Name),
+	}, logger)
+	httpServer := &http.Server{
+		Handler:           api.Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	authentication := "required"
+	switch {
+	case generated:
+		authentication = token + " (generated; set server.token to keep one)"
+	case token == "":
+		authentication = "not required (loopback hosts only)"
+	}
+	fmt.Println(ui.RenderHeader("🤖 Meeting Summary Generator", fmt.Sprintf("API powered by %s", aiCommand)))
+	fmt.Println(ui.RenderInfoBox(
+		fmt.Sprintf("🌐 Listening on: http://%s", listener.Addr()),
+		fmt.Sprintf("⚙️  Workers: %d, queue size: %d", max(concurrency, 1), max(queueSize, 1)),
+		fmt.Sprintf("🔐 Bearer token: %s", authentication),
+	))
+	if strings.TrimSpace(cfg.User.Name) == "" {
+		fmt.Println(ui.RenderWarning("user.name is not configured; every submission must include user_name"))
+	}
+
+	ctx, stop := signal.NotifyContext(cmd.Context(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- httpServer.Serve(listener)
+	}()
+
+	select {
+	case err := <-serveErr:
+		queue.Close()
+		return err
+	case <-ctx.Done():
+	}
+
+	fmt.Println(ui.RenderInfo("Shutting down; waiting for running jobs to finish..."))
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), serveShutdownTimeout)
+	defer cancel()
+	if err := httpServer.Shutdown(shutdownCtx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	queue.Close()
+	return nil
+}
+
+// generateServeToken returns a random bearer token for servers started
+// without one.
+func generateServeToken() (string, error) {
+	buf := make([]byte, 24)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate a server token: %w", err)
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// isLoopbackAddress reports whether a listen address only accepts local connections.
+func isLoopbackAddress(address string) bool {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+func init() {
+	rootCmd.AddCommand(serveCmd)
+	serveCmd.Flags().StringVar(&serveAddress, "addr", "", "Listen address (default server.address, 127.0.0.1:7733)")
+	serveCmd.Flags().StringVar(&serveToken, "token", "", "Require this bearer token (default server.token or MEETSUM_SERVER_TOKEN)")
+	serveCmd.Flags().BoolVar(&serveNoToken, "no-token", false, "Serve loopback requests without a bearer token")
+	serveCmd.Flags().IntVar(&serveConcurrency, "concurrency", 0, "Jobs to run at once (default server.concurrency)")
+	serveCmd.Flags().IntVar(&serveQueueSize, "queue-size", 0, "Jobs allowed to wait before submissions are rejected (default server.queue_size)")
+}
@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/bashfulrobot/meetsum/config"
+	"github.com/bashfulrobot/meetsum/internal/app"
+	"github.com/bashfulrobot/meetsum/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var slackEdit bool
+
+// slackCmd rebuilds the Slack mini summary from the saved summary
+var slackCmd = &cobra.Command{
+	Use:   "slack <meeting_directory>",
+	Short: "Rebuild the Slack mini summary from the saved summary",
+	Long: `Re-parse the meeting's main summary and rebuild the -slack.md mini summary
+from it, so hand edits to the summary carry over. The AI is not called.
+
+With --edit, the main summary is opened in $VISUAL or $EDITOR first and the
+Slack summary is rebuilt once the editor exits:
+  meetsum slack ~/Customers/Acme/2025-10-01 --edit`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeMeetingDir,
+	RunE:              runSlack,
+}
+
+func runSlack(cmd *cobra.Command, args []string) error {
+	cmd.SilenceUsage = true
+	meetingDir, err := resolveMeetingDir(args[0])
+	if err != nil {
+		return withExitCode(ExitMissingInput, err)
+	}
+
+	runtimeService := app.NewService(config.AppConfig, logger)
+	if slackEdit {
+		outputs, err := runtimeService.OutputPaths(meetingDir)
+		if err != nil {
+			return err
+		}
+		if _, err := os.Stat(outputs.Summary); err != nil {
+			return withExitCode(ExitMissingInput, fmt.Errorf("no summary found at %s; run meetsum on this directory first", outputs.Summary))
+		}
+		if err := openEditor(outputs.Summary); err != nil {
+			fmt.Println(ui.RenderError(err.Error()))
+			return err
+		}
+	}
+
+	result, err := runtimeService.RebuildSlackSummary(meetingDir)
+	if err != nil {
+		fmt.Println(ui.RenderError(fmt.Sprintf("Failed to rebuild Slack summary: %v", err)))
+		return withExitCode(ExitMissingInput, err)
+	}
+
+	fmt.Println()
+	fmt.Println(result.Content)
+	fmt.Println(ui.RenderInfoBox(
+		fmt.Sprintf("📄 Summary file: %s", filepath.Base(result.SummaryPath)),
+		fmt.Sprintf("📋 Slack summary: %s", filepath.Base(result.SlackOutputPath)),
+	))
+	fmt.Println(ui.RenderSuccess("🎉 Slack summary rebuilt."))
+	return nil
+}
+
+// openEditor opens path in the user's editor and waits for it to exit.
+// $VISUAL and $EDITOR may include arguments, such as "code --wait".
+func openEditor(path string) error {
+	editor := strings.TrimSpace(os.Getenv("VISUAL"))
+	if editor == "" {
+		editor = strings.TrimSpace(os.Getenv("EDITOR"))
+	}
+	if editor == "" {
+		return fmt.Errorf("--edit needs $VISUAL or $EDITOR to be set")
+	}
+	if !stdinIsTerminal() {
+		return fmt.Errorf("--edit needs an interactive terminal")
+	}
+
+	fields := strings.Fields(editor)
+	editorCmd := exec.Command(fields[0], append(fields[1:], path)...)
+	editorCmd.Stdin = os.Stdin
+	editorCmd.Stdout = os.Stdout
+	editorCmd.Stderr = os.Stderr
+	if err := editorCmd.Run(); err != nil {
+		return fmt.Errorf("editor %s failed: %w", fields[0], err)
+	}
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(slackCmd)
+	slackCmd.Flags().BoolVarP(&slackEdit, "edit", "e", false, "Open the main summary in $EDITOR before rebuilding")
+}
@@ -1,8 +1,10 @@
 package cmd
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/bashfulrobot/meetsum/config"
 	"github.com/bashfulrobot/meetsum/internal/summary"
@@ -17,8 +19,9 @@ var validateCmd = &cobra.Command{
 	Long: `Validate a meeting directory to ensure it contains all required files
 and check for optional context files. If no directory is specified,
 validates the configuration files and directory structure.`,
-	Args: cobra.MaximumNArgs(1),
-	RunE: runValidate,
+	Args:              cobra.MaximumNArgs(1),
+	ValidArgsFunction: completeMeetingDir,
+	RunE:              runValidate,
 }
 
 func runValidate(cmd *cobra.Command, args []string) error {
@@ -36,7 +39,21 @@ func validateMeetingDirectory(meetingDir string) error {
 		return err
 	}
 
-	results := buildMeetingDirectoryValidationResults(meetingDir, config.AppConfig.Files.PovInput)
+	dirConfig, err := config.AppConfig.ForDir(meetingDir)
+	if err != nil {
+		return err
+	}
+
+	results := buildMeetingDirectoryValidationResults(meetingDir, dirConfig.Config.Files.PovInput)
+	for _, file := range dirConfig.Files {
+		results = append(results, ui.FileValidationResult{
+			File:        config.DirConfigFile,
+			Required:    false,
+			Found:       true,
+			Path:        file,
+			Description: "Directory configuration overrides",
+		})
+	}
 	return ui.ShowFileValidationTable(results)
 }
 
@@ -84,6 +101,7 @@ func buildMeetingDirectoryValidationResults(meetingDir, povInputFile string) []u
 		"recording.mp4",
 		"recording.m4a",
 		"attendees.txt",
+		config.DefaultMetadataFile,
 	}
 
 	for _, file := range commonFiles {
@@ -125,6 +143,27 @@ func validateConfiguration() error {
 		},
 	}
 
+	// Each meeting type may bring its own instructions file and writing skill
+	for _, name := range config.AppConfig.MeetingTypeNames() {
+		meetingType, _ := config.AppConfig.GetMeetingType(name)
+		if strings.TrimSpace(meetingType.InstructionsFile) != "" {
+			results = append(results, ui.FileValidationResult{
+				File:        fmt.Sprintf("Instructions (%s)", name),
+				Required:    true,
+				Path:        config.AppConfig.GetInstructionsPathForType(name),
+				Description: fmt.Sprintf("AI instructions for %s meetings", name),
+			})
+		}
+		if skillPath := config.AppConfig.GetWritingSkillPathForType(name); skillPath != "" {
+			results = append(results, ui.FileValidationResult{
+				File:        fmt.Sprintf("Writing Skill (%s)", name),
+				Required:    true,
+				Path:        skillPath,
+				Description: fmt.Sprintf("Writing skill for %s meetings", name),
+			})
+		}
+	}
+
 	// Check each path/file
 	for i := range results {
 		if _, err := os.Stat(results[i].Path); err == nil {
@@ -0,0 +1,157 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/bashfulrobot/meetsum/config"
+	"github.com/bashfulrobot/meetsum/internal/app"
+	"github.com/bashfulrobot/meetsum/internal/meetings"
+	"github.com/bashfulrobot/meetsum/internal/notify"
+	"github.com/bashfulrobot/meetsum/internal/ui"
+	"github.com/bashfulrobot/meetsum/internal/watch"
+	"github.com/spf13/cobra"
+)
+
+var (
+	watchDebounce time.Duration
+	watchNoNotify bool
+)
+
+// watchCmd summarizes new transcripts as they appear
+var watchCmd = &cobra.Command{
+	Use:   "watch [root_directory]",
+	Short: "Watch the customer tree and summarize new transcripts automatically",
+	Long: `Watch paths.file_browser_root_dir (or the given directory) recursively.
+When a meeting directory gains a .txt transcript and has no summary yet,
+meetsum waits for the debounce period (watch.debounce, default 30s) so copies
+and syncs can finish, then runs the normal summary pipeline and sends a
+desktop notification with the result.
+
+Meetings are processed one at a time. Files meetsum writes itself, including
+the renamed transcript, never trigger another run. Directories that already
+hold a transcript when watching starts are left alone; use 'meetsum batch'
+for those.`,
+	Args:              cobra.MaximumNArgs(1),
+	ValidArgsFunction: completeMeetingDir,
+	RunE:              runWatch,
+}
+
+func runWatch(cmd *cobra.Command, args []string) error {
+	cfg := config.AppConfig
+	root := cfg.Paths.FileBrowserRootDir
+	if len(args) > 0 {
+		root = args[0]
+	}
+	if root == "" {
+		return fmt.Errorf("no directory to watch: pass one or set paths.file_browser_root_dir")
+	}
+	root, err := resolveMeetingDir(root)
+	if err != nil {
+		return err
+	}
+
+	debounce := cfg.GetWatchDebounce()
+	if cmd.Flags().Changed("debounce") {
+		debounce = watchDebounce
+	}
+	notifications := cfg.Watch.Notify && !watchNoNotify
+
+	// Each meeting is checked again with its .meetsum.yaml overrides, which
+	// may select another command
+	runtimeService := app.NewService(cfg, logger)
+	aiCommand, err := runtimeService.Preflight()
+	if err != nil {
+		fmt.Println(ui.RenderWarning(fmt.Sprintf("Preflight: %v; only meetings whose .meetsum.yaml selects another command can be summarized", err)))
+	}
+
+	userName := strings.TrimSpace(cfg.User.Name)
+	if userName == "" {
+		if userName, err = getUserName(); err != nil {
+			return err
+		}
+	}
+
+	watcher, err := watch.New(cfg, root, debounce, func(meeting meetings.Meeting) {
+		summarizeWatchedMeeting(runtimeService, userName, meeting, notifications)
+	}, logger)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(ui.RenderHeader("🤖 Meeting Summary Generator", fmt.Sprintf("Watching for transcripts, powered by %s", aiCommand)))
+	fmt.Println(ui.RenderInfoBox(
+		fmt.Sprintf("👀 Watching: %s (%d directories)", root, watcher.Directories()),
+		fmt.Sprintf("⏱️  Debounce: %s", debounce),
+		fmt.Sprintf("👤 Writing as: %s", userName),
+	))
+	fmt.Println(ui.RenderInfo("Press Ctrl+C to stop."))
+
+	ctx, stop := signal.NotifyContext(cmd.Context(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	return watcher.Run(ctx)
+}
+
+// summarizeWatchedMeeting runs the pipeline for one meeting and reports the
+// outcome on screen, in the log and as a desktop notification.
+func summarizeWatchedMeeting(runtimeService *app.Service, userName string, meeting meetings.Meeting, notifications bool) {
+	label := fmt.Sprintf("%s %s", meeting.Customer, meeting.Date)
+	fmt.Println(ui.RenderInfo(fmt.Sprintf("📄 New transcript in %s, summarizing...", meeting.Dir)))
+	if logger != nil {
+		logger.Info("summarizing watched meeting", "dir", meeting.Dir, "transcript", meeting.Transcript)
+	}
+
+	result, err := runWatchedMeeting(runtimeService, userName, meeting.Dir)
+	if err != nil {
+		fmt.Println(ui.RenderError(fmt.Sprintf("%s: %v", label, err)))
+		if logger != nil {
+			logger.Error("watched meeting failed", "dir", meeting.Dir, "error", err)
+		}
+		sendWatchNotification(notifications, "meetsum: summary failed", fmt.Sprintf("%s: %v", label, err))
+		return
+	}
+
+	fmt.Println(ui.RenderSuccess(fmt.Sprintf("%s: saved %s", label, filepath.Base(result.OutputPath))))
+	for _, warning := range result.Warnings() {
+		fmt.Println(ui.RenderWarning(warning))
+	}
+	if logger != nil {
+		logger.Info("watched meeting summarized", "dir", meeting.Dir, "summary", result.OutputPath)
+	}
+	sendWatchNotification(notifications, "meetsum: summary ready", fmt.Sprintf("%s — %s", label, filepath.Base(result.OutputPath)))
+}
+
+func runWatchedMeeting(runtimeService *app.Service, userName, dir string) (app.RunResult, error) {
+	session, err := runtimeService.Prepare(app.RunRequest{UserName: userName, MeetingDir: dir})
+	if err != nil {
+		return app.RunResult{}, err
+	}
+	if _, err := session.Preflight(); err != nil {
+		return app.RunResult{}, err
+	}
+	return session.Run()
+}
+
+func sendWatchNotification(enabled bool, title, message string) {
+	if !enabled {
+		return
+	}
+	if err := notify.Send(title, message); err != nil && logger != nil {
+		if errors.Is(err, notify.ErrUnsupported) {
+			logger.Debug("desktop notification skipped", "reason", err)
+			return
+		}
+		logger.Warn("desktop notification failed", "error", err)
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(watchCmd)
+	watchCmd.Flags().DurationVar(&watchDebounce, "debounce", config.DefaultWatchDebounce, "Quiet period after the last transcript change before summarizing")
+	watchCmd.Flags().BoolVar(&watchNoNotify, "no-notify", false, "Disable desktop notifications")
+}
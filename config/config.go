@@ -3,7 +3,9 @@ package config
 import (
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 )
@@ -18,8 +20,22 @@ type Config struct {
 
 	Files struct {
 		PovInput string `mapstructure:"pov_input"`
+		Metadata string `mapstructure:"metadata"`
 	} `mapstructure:"files"`
 
+	Output struct {
+		FilenameTemplate string `mapstructure:"filename_template"`
+	} `mapstructure:"output"`
+
+	Meetings struct {
+		DefaultType string                 `mapstructure:"default_type"`
+		TypeFile    string                 `mapstructure:"type_file"`
+		Types       map[string]MeetingType `mapstructure:"types"`
+		// Customers maps a customer name to the meeting type its meetings
+		// use when neither --type nor a type file names one.
+		Customers map[string]string `mapstructure:"customers"`
+	} `mapstructure:"meetings"`
+
 	AI struct {
 		Command string   `mapstructure:"command"`
 		Args    []string `mapstructure:"args"`
@@ -28,6 +44,7 @@ type Config struct {
 	Features struct {
 		TraceMode   bool `mapstructure:"trace_mode"`
 		FileBrowser bool `mapstructure:"file_browser"`
+		LintFix     bool `mapstructure:"lint_fix"`
 	} `mapstructure:"features"`
 
 	Logging struct {
@@ -43,9 +60,148 @@ type Config struct {
 
 	User struct {
 		Name string `mapstructure:"name"`
+		// InternalDomains are your organization's email domains. Invite
+		// attendees from them never name the customer.
+		InternalDomains []string `mapstructure:"internal_domains"`
 	} `mapstructure:"user"`
+
+	Slack struct {
+		Enabled    bool                   `mapstructure:"enabled"`
+		WebhookURL string                 `mapstructure:"webhook_url"`
+		BotToken   string                 `mapstructure:"bot_token"`
+		Channel    string                 `mapstructure:"channel"`
+		APIURL     string                 `mapstructure:"api_url"`
+		Customers  map[string]SlackTarget `mapstructure:"customers"`
+	} `mapstructure:"slack"`
+
+	Notes struct {
+		Enabled          bool     `mapstructure:"enabled"`
+		VaultDir         string   `mapstructure:"vault_dir"`
+		FilenameTemplate string   `mapstructure:"filename_template"`
+		CustomerPage     string   `mapstructure:"customer_page"`
+		Tags             []string `mapstructure:"tags"`
+	} `mapstructure:"notes"`
+
+	Email struct {
+		Enabled          bool     `mapstructure:"enabled"`
+		From             string   `mapstructure:"from"`
+		To               []string `mapstructure:"to"`
+		Cc               []string `mapstructure:"cc"`
+		SubjectTemplate  string   `mapstructure:"subject_template"`
+		InstructionsFile string   `mapstructure:"instructions_file"`
+	} `mapstructure:"email"`
+
+	Git struct {
+		AutoCommit      bool   `mapstructure:"auto_commit"`
+		MessageTemplate string `mapstructure:"message_template"`
+		Push            bool   `mapstructure:"push"`
+		Remote          string `mapstructure:"remote"`
+	} `mapstructure:"git"`
+
+	Webhooks struct {
+		URLs     []string `mapstructure:"urls"`
+		Secret   string   `mapstructure:"secret"`
+		Attempts int      `mapstructure:"attempts"`
+	} `mapstructure:"webhooks"`
+
+	Trackers struct {
+		GitHub struct {
+			APIURL string   `mapstructure:"api_url"`
+			Token  string   `mapstructure:"token"`
+			Repo   string   `mapstructure:"repo"`
+			Labels []string `mapstructure:"labels"`
+		} `mapstructure:"github"`
+		GitLab struct {
+			APIURL  string   `mapstructure:"api_url"`
+			Token   string   `mapstructure:"token"`
+			Project string   `mapstructure:"project"`
+			Labels  []string `mapstructure:"labels"`
+		} `mapstructure:"gitlab"`
+		Jira struct {
+			URL       string   `mapstructure:"url"`
+			Email     string   `mapstructure:"email"`
+			Token     string   `mapstructure:"token"`
+			Project   string   `mapstructure:"project"`
+			IssueType string   `mapstructure:"issue_type"`
+			Labels    []string `mapstructure:"labels"`
+		} `mapstructure:"jira"`
+		Assignees map[string]TrackerUsers `mapstructure:"assignees"`
+	} `mapstructure:"trackers"`
+
+	Server struct {
+		Address     string `mapstructure:"address"`
+		Token       string `mapstructure:"token"`
+		Concurrency int    `mapstructure:"concurrency"`
+		QueueSize   int    `mapstructure:"queue_size"`
+		History     int    `mapstructure:"history"`
+	} `mapstructure:"server"`
+
+	Watch struct {
+		Debounce time.Duration `mapstructure:"debounce"`
+		Notify   bool          `mapstructure:"notify"`
+	} `mapstructure:"watch"`
+
+	Batch struct {
+		Workers int `mapstructure:"workers"`
+		// RateLimits caps summary runs started per minute, keyed by AI command
+		RateLimits map[string]int `mapstructure:"rate_limits"`
+	} `mapstructure:"batch"`
+}
+
+// MeetingType is a named profile of per-meeting-type overrides. Empty fields
+// fall back to paths.instructions_file, output.filename_template, the default
+// Slack section order and the skills settings.
+type MeetingType struct {
+	InstructionsFile string `mapstructure:"instructions_file"`
+	FilenameTemplate string `mapstructure:"filename_template"`
+	// PromptExtras is added to the prompt after the instructions.
+	PromptExtras string `mapstructure:"prompt_extras"`
+	// SlackSections orders the sections of the Slack mini summary.
+	SlackSections []string `mapstructure:"slack_sections"`
+	// WritingSkill replaces skills.writing_style and skills.humanizer.
+	WritingSkill string `mapstructure:"writing_skill"`
 }
 
+// TrackerUsers maps a person named in summaries to their issue tracker usernames.
+// The Jira value is the account ID used by Jira Cloud.
+type TrackerUsers struct {
+	GitHub string `mapstructure:"github"`
+	GitLab string `mapstructure:"gitlab"`
+	Jira   string `mapstructure:"jira"`
+}
+
+// SlackTarget holds per-customer Slack delivery overrides.
+// Empty fields fall back to the top-level slack settings.
+type SlackTarget struct {
+	WebhookURL string `mapstructure:"webhook_url"`
+	BotToken   string `mapstructure:"bot_token"`
+	Channel    string `mapstructure:"channel"`
+}
+
+// Defaults for summary filenames and meeting types. The default template and
+// type reproduce the historical {date}-{customer}-cadence-call-summary.md name.
+const (
+	DefaultFilenameTemplate = "{date}-{customer}-{type}-summary.md"
+	DefaultMeetingType      = "cadence-call"
+	DefaultMeetingTypeFile  = ".meetsum-type"
+	DefaultMetadataFile     = "meeting.yaml"
+	DefaultServerAddress    = "127.0.0.1:7733"
+)
+
+// Defaults for the follow-up email subject and the auto-commit message. They
+// also stand in when a configured template expands to nothing.
+const (
+	DefaultEmailSubjectTemplate = "{customer} follow-up {date}"
+	DefaultGitMessageTemplate   = "Add {customer} meeting summary for {date} ({provider})"
+)
+
+// DefaultWatchDebounce is how long `meetsum watch` waits after the last
+// transcript change before summarizing, so copies and syncs can finish.
+const DefaultWatchDebounce = 30 * time.Second
+
+// DefaultBatchWorkers is how many meetings `meetsum batch` summarizes at once.
+const DefaultBatchWorkers = 2
+
 var AppConfig *Config
 
 // LoadConfig loads configuration from file
@@ -66,15 +222,44 @@ func LoadConfig() error {
 	viper.SetDefault("paths.automation_dir", filepath.Join(homeDir, "Documents", "Company", "automation", "summaries"))
 	viper.SetDefault("paths.instructions_file", "Meeting-summary-llm-instructions.md")
 	viper.SetDefault("files.pov_input", "pov-input.md")
+	viper.SetDefault("files.metadata", DefaultMetadataFile)
+	viper.SetDefault("output.filename_template", DefaultFilenameTemplate)
+	viper.SetDefault("meetings.default_type", DefaultMeetingType)
+	viper.SetDefault("meetings.type_file", DefaultMeetingTypeFile)
 	viper.SetDefault("skills.writing_style", filepath.Join(homeDir, ".claude", "skills", "writing-style", "writing-style.md"))
 	viper.SetDefault("skills.humanizer", filepath.Join(homeDir, ".claude", "skills", "humanizer", "humanizer.md"))
 	viper.SetDefault("ai.command", "gemini")
 	viper.SetDefault("ai.args", []string{})
 	viper.SetDefault("features.trace_mode", false)
 	viper.SetDefault("features.file_browser", true)
+	viper.SetDefault("features.lint_fix", true)
 	viper.SetDefault("logging.level", "info")
 	viper.SetDefault("logging.file", filepath.Join(homeDir, ".config", "meetsum", "error.log"))
 	viper.SetDefault("logging.output", "screen")
+	viper.SetDefault("slack.enabled", false)
+	viper.SetDefault("slack.api_url", "https://slack.com/api")
+	viper.SetDefault("notes.enabled", false)
+	viper.SetDefault("notes.filename_template", "Meetings/{customer}/{date} {customer} meeting.md")
+	viper.SetDefault("notes.customer_page", "{customer}")
+	viper.SetDefault("notes.tags", []string{"meeting", "meetsum"})
+	viper.SetDefault("email.enabled", false)
+	viper.SetDefault("email.subject_template", DefaultEmailSubjectTemplate)
+	viper.SetDefault("git.auto_commit", false)
+	viper.SetDefault("git.message_template", DefaultGitMessageTemplate)
+	viper.SetDefault("git.push", false)
+	viper.SetDefault("git.remote", "origin")
+	viper.SetDefault("webhooks.urls", []string{})
+	viper.SetDefault("webhooks.attempts", 3)
+	viper.SetDefault("trackers.github.api_url", "https://api.github.com")
+	viper.SetDefault("trackers.gitlab.api_url", "https://gitlab.com/api/v4")
+	viper.SetDefault("trackers.jira.issue_type", "Task")
+	viper.SetDefault("server.address", DefaultServerAddress)
+	viper.SetDefault("server.concurrency", 1)
+	viper.SetDefault("server.queue_size", 16)
+	viper.SetDefault("server.history", 100)
+	viper.SetDefault("watch.debounce", DefaultWatchDebounce)
+	viper.SetDefault("watch.notify", true)
+	viper.SetDefault("batch.workers", DefaultBatchWorkers)
 
 	// Try to read config file
 	if err := viper.ReadInConfig(); err != nil {
@@ -93,11 +278,133 @@ func (c *Config) GetInstructionsPath() string {
 	return filepath.Join(c.Paths.AutomationDir, c.Paths.InstructionsFile)
 }
 
+// GetMeetingType returns the overrides configured for a meeting type. Type
+// names are matched case-insensitively because viper lowercases map keys.
+func (c *Config) GetMeetingType(name string) (MeetingType, bool) {
+	for typeName, meetingType := range c.Meetings.Types {
+		if strings.EqualFold(typeName, name) {
+			return meetingType, true
+		}
+	}
+	return MeetingType{}, false
+}
+
+// MeetingTypeNames returns the configured meeting type names, sorted.
+func (c *Config) MeetingTypeNames() []string {
+	names := make([]string, 0, len(c.Meetings.Types))
+	for name := range c.Meetings.Types {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// GetInstructionsPathForType returns the instructions file for a meeting type,
+// resolved relative to the automation directory, falling back to the default
+// instructions file when the type has none configured.
+func (c *Config) GetInstructionsPathForType(name string) string {
+	meetingType, ok := c.GetMeetingType(name)
+	path := c.expandHome(strings.TrimSpace(meetingType.InstructionsFile))
+	if !ok || path == "" {
+		return c.GetInstructionsPath()
+	}
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(c.Paths.AutomationDir, path)
+}
+
+// GetFilenameTemplateForType returns the summary filename template for a
+// meeting type, falling back to output.filename_template.
+func (c *Config) GetFilenameTemplateForType(name string) string {
+	if meetingType, ok := c.GetMeetingType(name); ok && strings.TrimSpace(meetingType.FilenameTemplate) != "" {
+		return meetingType.FilenameTemplate
+	}
+	if strings.TrimSpace(c.Output.FilenameTemplate) == "" {
+		return DefaultFilenameTemplate
+	}
+	return c.Output.FilenameTemplate
+}
+
+// GetDefaultMeetingType returns the meeting type used when neither --type, a
+// meeting type file nor meetings.customers names one.
+func (c *Config) GetDefaultMeetingType() string {
+	if strings.TrimSpace(c.Meetings.DefaultType) == "" {
+		return DefaultMeetingType
+	}
+	return c.Meetings.DefaultType
+}
+
+// GetCustomerMeetingType returns the default meeting type configured for a
+// customer, or "" when there is none. Customers are matched case-insensitively.
+func (c *Config) GetCustomerMeetingType(customer string) string {
+	for name, meetingType := range c.Meetings.Customers {
+		if strings.EqualFold(name, customer) {
+			return strings.TrimSpace(meetingType)
+		}
+	}
+	return ""
+}
+
+// GetPromptExtrasForType returns the extra prompt text for a meeting type.
+func (c *Config) GetPromptExtrasForType(name string) string {
+	meetingType, _ := c.GetMeetingType(name)
+	return strings.TrimSpace(meetingType.PromptExtras)
+}
+
+// GetSlackSectionsForType returns the Slack mini summary section order for a
+// meeting type, or nil for the default order.
+func (c *Config) GetSlackSectionsForType(name string) []string {
+	meetingType, _ := c.GetMeetingType(name)
+	return meetingType.SlackSections
+}
+
+// GetWritingSkillPathForType returns the writing skill configured for a
+// meeting type, resolved like paths.instructions_file, or "" when the type
+// has none.
+func (c *Config) GetWritingSkillPathForType(name string) string {
+	meetingType, _ := c.GetMeetingType(name)
+	path := c.expandHome(strings.TrimSpace(meetingType.WritingSkill))
+	if path == "" || filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(c.Paths.AutomationDir, path)
+}
+
+// GetMeetingTypeFile returns the per-directory meeting type file name.
+func (c *Config) GetMeetingTypeFile() string {
+	if strings.TrimSpace(c.Meetings.TypeFile) == "" {
+		return DefaultMeetingTypeFile
+	}
+	return c.Meetings.TypeFile
+}
+
 // GetPovInputPath returns the full path to the POV input file in a meeting directory
 func (c *Config) GetPovInputPath(meetingDir string) string {
 	return filepath.Join(meetingDir, c.Files.PovInput)
 }
 
+// GetMetadataPath returns the full path to the per-meeting metadata file
+func (c *Config) GetMetadataPath(meetingDir string) string {
+	name := strings.TrimSpace(c.Files.Metadata)
+	if name == "" {
+		name = DefaultMetadataFile
+	}
+	return filepath.Join(meetingDir, name)
+}
+
+// GetInternalDomains returns user.internal_domains lowercased, without blanks
+// or a leading "@".
+func (c *Config) GetInternalDomains() []string {
+	var domains []string
+	for _, domain := range c.User.InternalDomains {
+		if domain = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(domain), "@")); domain != "" {
+			domains = append(domains, domain)
+		}
+	}
+	return domains
+}
+
 // GetWritingSkillPath returns the path to the best available writing skill file.
 // Priority: writing_style > humanizer > "" (none).
 func (c *Config) GetWritingSkillPath() string {
@@ -127,7 +434,127 @@ func (c *Config) expandHome(path string) string {
 	return path
 }
 
+// GetNotesVaultDir returns the expanded knowledge base vault directory
+func (c *Config) GetNotesVaultDir() string {
+	return c.expandHome(c.Notes.VaultDir)
+}
+
+// GetEmailInstructionsPath returns the custom follow-up email instructions path,
+// resolved relative to the automation directory. Empty means use the built-in prompt.
+func (c *Config) GetEmailInstructionsPath() string {
+	path := c.expandHome(strings.TrimSpace(c.Email.InstructionsFile))
+	if path == "" || filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(c.Paths.AutomationDir, path)
+}
+
 // GetLogFilePath returns the expanded log file path
 func (c *Config) GetLogFilePath() string {
 	return c.expandHome(c.Logging.File)
 }
+
+// GetSlackTarget returns the Slack delivery target for a customer, merging
+// per-customer overrides over the top-level slack settings. Customer keys are
+// matched case-insensitively because viper lowercases map keys.
+func (c *Config) GetSlackTarget(customer string) SlackTarget {
+	target := SlackTarget{
+		WebhookURL: c.Slack.WebhookURL,
+		BotToken:   c.Slack.BotToken,
+		Channel:    c.Slack.Channel,
+	}
+
+	for name, override := range c.Slack.Customers {
+		if !strings.EqualFold(name, customer) {
+			continue
+		}
+		if override.WebhookURL != "" {
+			target.WebhookURL = override.WebhookURL
+		}
+		if override.BotToken != "" {
+			target.BotToken = override.BotToken
+		}
+		if override.Channel != "" {
+			target.Channel = override.Channel
+		}
+		break
+	}
+
+	return target
+}
+
+// GetTrackerUser returns the tracker username configured for a person, or ""
+// when unmapped. Names are matched case-insensitively because viper lowercases
+// map keys.
+func (c *Config) GetTrackerUser(tracker, person string) string {
+	for name, users := range c.Trackers.Assignees {
+		if !strings.EqualFold(name, strings.TrimSpace(person)) {
+			continue
+		}
+		switch tracker {
+		case "github":
+			return users.GitHub
+		case "gitlab":
+			return users.GitLab
+		case "jira":
+			return users.Jira
+		}
+	}
+	return ""
+}
+
+// GetTrackerToken returns the configured API token for a tracker, falling back
+// to the tracker's conventional environment variable.
+func (c *Config) GetTrackerToken(tracker string) string {
+	var token, envVar string
+	switch tracker {
+	case "github":
+		token, envVar = c.Trackers.GitHub.Token, "GITHUB_TOKEN"
+	case "gitlab":
+		token, envVar = c.Trackers.GitLab.Token, "GITLAB_TOKEN"
+	case "jira":
+		token, envVar = c.Trackers.Jira.Token, "JIRA_API_TOKEN"
+	default:
+		return ""
+	}
+	if strings.TrimSpace(token) != "" {
+		return token
+	}
+	return os.Getenv(envVar)
+}
+
+// GetServerToken returns the bearer token required by `meetsum serve`, falling
+// back to MEETSUM_SERVER_TOKEN. Empty means no authentication.
+func (c *Config) GetServerToken() string {
+	if token := strings.TrimSpace(c.Server.Token); token != "" {
+		return token
+	}
+	return strings.TrimSpace(os.Getenv("MEETSUM_SERVER_TOKEN"))
+}
+
+// GetWatchDebounce returns the watch debounce period.
+func (c *Config) GetWatchDebounce() time.Duration {
+	if c.Watch.Debounce <= 0 {
+		return DefaultWatchDebounce
+	}
+	return c.Watch.Debounce
+}
+
+// GetBatchWorkers returns the number of concurrent batch workers.
+func (c *Config) GetBatchWorkers() int {
+	if c.Batch.Workers <= 0 {
+		return DefaultBatchWorkers
+	}
+	return c.Batch.Workers
+}
+
+// GetBatchRateLimit returns the runs per minute allowed for an AI command.
+// Zero means unlimited.
+func (c *Config) GetBatchRateLimit(provider string) int {
+	for name, limit := range c.Batch.RateLimits {
+		if strings.EqualFold(name, provider) && limit > 0 {
+			return limit
+		}
+	}
+	return 0
+}
@@ -0,0 +1,173 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/go-viper/mapstructure/v2"
+	"github.com/spf13/viper"
+)
+
+// DirConfigFile is the per-directory override file. Files found from the
+// customer folder down to the meeting directory are merged in that order.
+const DirConfigFile = ".meetsum.yaml"
+
+// DirConfig is the configuration in effect for one meeting directory.
+type DirConfig struct {
+	// Config is the merged configuration. It is the base configuration itself
+	// when no override file applies.
+	Config *Config
+	// Files are the override files applied, customer folder first.
+	Files []string
+	// Values holds every effective setting by dotted key, e.g. "ai.command".
+	Values map[string]any
+	// Sources maps a dotted key to the override file that set it. Keys that
+	// are not listed come from settings.yaml or the defaults.
+	Sources map[string]string
+}
+
+// DirConfigFiles returns the override files that apply to meetingDir,
+// customer folder first. The walk stops at the customer folder below
+// paths.file_browser_root_dir, or at the meeting directory's parent when the
+// meeting lives outside the root.
+func (c *Config) DirConfigFiles(meetingDir string) []string {
+	dir, err := filepath.Abs(c.expandHome(meetingDir))
+	if err != nil {
+		return nil
+	}
+
+	stop := filepath.Dir(dir)
+	if root, rel, ok := c.rootRelative(dir); ok {
+		if rel == "." {
+			stop = dir
+		} else {
+			stop = filepath.Join(root, strings.Split(rel, string(filepath.Separator))[0])
+		}
+	}
+
+	var files []string
+	for current := dir; ; current = filepath.Dir(current) {
+		path := filepath.Join(current, DirConfigFile)
+		if info, err := os.Stat(path); err == nil && !info.IsDir() {
+			files = append([]string{path}, files...)
+		}
+		if current == stop || current == filepath.Dir(current) {
+			break
+		}
+	}
+	return files
+}
+
+// UnderCustomerRoot reports whether dir is paths.file_browser_root_dir or
+// lies below it.
+func (c *Config) UnderCustomerRoot(dir string) bool {
+	dir, err := filepath.Abs(c.expandHome(dir))
+	if err != nil {
+		return false
+	}
+	_, _, ok := c.rootRelative(dir)
+	return ok
+}
+
+// rootRelative returns the absolute customer root and the path of the
+// absolute dir relative to it, or false when dir is outside the root or no
+// root is configured.
+func (c *Config) rootRelative(dir string) (string, string, bool) {
+	root := c.expandHome(c.Paths.FileBrowserRootDir)
+	if root == "" {
+		return "", "", false
+	}
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return "", "", false
+	}
+	rel, err := filepath.Rel(root, dir)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", "", false
+	}
+	return root, rel, true
+}
+
+// ForDir merges the override files for meetingDir over c. Maps are merged key
+// by key; scalars and lists replace the inherited value. c is not modified.
+func (c *Config) ForDir(meetingDir string) (*DirConfig, error) {
+	v := viper.New()
+	if err := v.MergeConfigMap(settingsMap(c).(map[string]any)); err != nil {
+		return nil, err
+	}
+
+	result := &DirConfig{Config: c, Files: c.DirConfigFiles(meetingDir), Sources: make(map[string]string)}
+	for _, path := range result.Files {
+		override := viper.New()
+		override.SetConfigFile(path)
+		override.SetConfigType("yaml")
+		if err := override.ReadInConfig(); err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		if err := v.MergeConfigMap(override.AllSettings()); err != nil {
+			return nil, fmt.Errorf("failed to merge %s: %w", path, err)
+		}
+		for _, key := range override.AllKeys() {
+			result.Sources[key] = path
+		}
+	}
+
+	if len(result.Files) > 0 {
+		merged := &Config{}
+		if err := v.Unmarshal(merged); err != nil {
+			return nil, fmt.Errorf("invalid directory configuration: %w", err)
+		}
+		result.Config = merged
+	}
+
+	result.Values = make(map[string]any)
+	for _, key := range v.AllKeys() {
+		result.Values[key] = v.Get(key)
+	}
+	return result, nil
+}
+
+// SettingsFileSource returns the settings file LoadConfig read key from, or ""
+// when the key keeps its default.
+func SettingsFileSource(key string) string {
+	if !viper.InConfig(key) {
+		return ""
+	}
+	return viper.ConfigFileUsed()
+}
+
+// settingsMap converts a configuration value to the nested maps viper works
+// with, keyed by mapstructure tag.
+func settingsMap(value any) any {
+	rv := reflect.ValueOf(value)
+	if rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil
+		}
+		return settingsMap(rv.Elem().Interface())
+	}
+
+	switch rv.Kind() {
+	case reflect.Struct:
+		var fields map[string]any
+		if err := mapstructure.Decode(value, &fields); err != nil {
+			return value
+		}
+		return settingsMap(fields)
+	case reflect.Map:
+		if rv.IsNil() {
+			return nil
+		}
+		settings := make(map[string]any, rv.Len())
+		iter := rv.MapRange()
+		for iter.Next() {
+			settings[fmt.Sprint(iter.Key().Interface())] = settingsMap(iter.Value().Interface())
+		}
+		return settings
+	default:
+		return value
+	}
+}
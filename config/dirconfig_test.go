@@ -0,0 +1,123 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func writeDirConfig(t *testing.T, dir, content string) string {
+	t.Helper()
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatalf("failed to create %s: %v", dir, err)
+	}
+	path := filepath.Join(dir, DirConfigFile)
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write %s: %v", path, err)
+	}
+	return path
+}
+
+func TestForDirMergesOverrides(t *testing.T) {
+	root := filepath.Join(t.TempDir(), "Customers")
+	customerDir := filepath.Join(root, "Acme")
+	meetingDir := filepath.Join(customerDir, "2026-03-02")
+
+	writeDirConfig(t, root, "ai:\n  command: ignored\n")
+	customerFile := writeDirConfig(t, customerDir, `ai:
+  command: claude
+  args: ["-p"]
+files:
+  pov_input: acme-context.md
+meetings:
+  customers:
+    acme: qbr
+`)
+	meetingFile := writeDirConfig(t, meetingDir, "ai:\n  args: [\"--model\", \"opus\"]\n")
+
+	base := &Config{}
+	base.Paths.FileBrowserRootDir = root
+	base.Files.PovInput = "pov-input.md"
+	base.AI.Command = "gemini"
+	base.AI.Args = []string{"--yolo"}
+	base.Watch.Debounce = 30 * time.Second
+	base.Meetings.Types = map[string]MeetingType{"qbr": {InstructionsFile: "qbr.md"}}
+
+	result, err := base.ForDir(meetingDir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(result.Files, []string{customerFile, meetingFile}) {
+		t.Fatalf("expected the customer and meeting files, got %v", result.Files)
+	}
+
+	cfg := result.Config
+	if cfg.AI.Command != "claude" || !reflect.DeepEqual(cfg.AI.Args, []string{"--model", "opus"}) {
+		t.Errorf("unexpected AI settings: %+v", cfg.AI)
+	}
+	if cfg.Files.PovInput != "acme-context.md" || cfg.Watch.Debounce != 30*time.Second {
+		t.Errorf("unexpected merged settings: %+v %v", cfg.Files, cfg.Watch.Debounce)
+	}
+	if cfg.GetInstructionsPathForType("qbr") != "qbr.md" || cfg.GetCustomerMeetingType("Acme") != "qbr" {
+		t.Errorf("maps were not merged: %+v %+v", cfg.Meetings.Types, cfg.Meetings.Customers)
+	}
+
+	if result.Sources["ai.command"] != customerFile || result.Sources["ai.args"] != meetingFile {
+		t.Errorf("unexpected sources: %v", result.Sources)
+	}
+	if _, ok := result.Sources["paths.file_browser_root_dir"]; ok {
+		t.Error("inherited settings must not have an override source")
+	}
+	if result.Values["ai.command"] != "claude" {
+		t.Errorf("unexpected effective value: %v", result.Values["ai.command"])
+	}
+
+	if base.AI.Command != "gemini" || !reflect.DeepEqual(base.AI.Args, []string{"--yolo"}) {
+		t.Errorf("base config was modified: %+v", base.AI)
+	}
+}
+
+func TestForDirWithoutOverrides(t *testing.T) {
+	base := &Config{}
+	base.AI.Command = "gemini"
+	meetingDir := filepath.Join(t.TempDir(), "Acme", "2026-03-02")
+	if err := os.MkdirAll(meetingDir, 0755); err != nil {
+		t.Fatalf("failed to create meeting dir: %v", err)
+	}
+
+	result, err := base.ForDir(meetingDir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Config != base || len(result.Files) != 0 || result.Values["ai.command"] != "gemini" {
+		t.Errorf("expected the base config, got %+v", result)
+	}
+}
+
+func TestDirConfigFilesOutsideRoot(t *testing.T) {
+	outside := t.TempDir()
+	customerDir := filepath.Join(outside, "Acme")
+	meetingDir := filepath.Join(customerDir, "2026-03-02")
+	writeDirConfig(t, outside, "ai:\n  command: ignored\n")
+	customerFile := writeDirConfig(t, customerDir, "ai:\n  command: claude\n")
+	if err := os.MkdirAll(meetingDir, 0755); err != nil {
+		t.Fatalf("failed to create meeting dir: %v", err)
+	}
+
+	base := &Config{}
+	base.Paths.FileBrowserRootDir = filepath.Join(t.TempDir(), "Customers")
+	if files := base.DirConfigFiles(meetingDir); !reflect.DeepEqual(files, []string{customerFile}) {
+		t.Errorf("expected only the parent folder's file, got %v", files)
+	}
+}
+
+func TestForDirInvalidFile(t *testing.T) {
+	meetingDir := filepath.Join(t.TempDir(), "Acme", "2026-03-02")
+	writeDirConfig(t, meetingDir, "ai: [unclosed\n")
+
+	if _, err := (&Config{}).ForDir(meetingDir); err == nil {
+		t.Fatal("expected an error for invalid YAML")
+	}
+}
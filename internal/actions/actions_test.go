@@ -0,0 +1,185 @@
+package actions
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testSummary = `*_2026-02-04 ACME CADENCE CALL SUMMARY_*
+
+*HIGHLIGHTS*
+
+- Acme is moving forward.
+
+*ACTION ITEMS*
+
+- John Doe: Send the proposal document by Friday.
+- Jane Smith & Tester: Schedule a follow-up call next week.
+- Tester: Share the migration guide, with notes; by March 3.
+- Review the rollout plan.
+
+*MEETING RECORDING*
+
+- [Meeting Recording](PLACEHOLDER_URL)`
+
+func TestParseSummary(t *testing.T) {
+	items := ParseSummary(testSummary, "2026-02-04")
+	if len(items) != 4 {
+		t.Fatalf("expected 4 items, got %d: %+v", len(items), items)
+	}
+
+	if items[0].Assignee != "John Doe" || items[0].Task != "Send the proposal document by Friday." {
+		t.Errorf("unexpected first item: %+v", items[0])
+	}
+	if got := items[0].Due.Format("2006-01-02"); got != "2026-02-06" {
+		t.Errorf("expected Friday after 2026-02-04 (Wed), got %s", got)
+	}
+	if items[0].DueText != "by Friday" {
+		t.Errorf("expected due phrase preserved, got %q", items[0].DueText)
+	}
+	if got := items[1].Due.Format("2006-01-02"); got != "2026-02-13" {
+		t.Errorf("expected next week's Friday, got %s", got)
+	}
+	if got := items[2].Due.Format("2006-01-02"); got != "2026-03-03" {
+		t.Errorf("expected March 3, got %s", got)
+	}
+	if items[3].Assignee != "" || items[3].HasDue() {
+		t.Errorf("expected unassigned item without deadline, got %+v", items[3])
+	}
+}
+
+func TestParseSummaryWithoutMeetingDateKeepsDeadlinesUnresolved(t *testing.T) {
+	items := ParseSummary(testSummary, "")
+	for _, item := range items {
+		if item.HasDue() {
+			t.Errorf("expected no resolved deadline without a meeting date: %+v", item)
+		}
+	}
+}
+
+func TestResolveDeadline(t *testing.T) {
+	meeting := time.Date(2026, 2, 4, 0, 0, 0, 0, time.UTC) // Wednesday
+
+	cases := []struct {
+		text   string
+		expect string
+	}{
+		{"send it by Friday", "2026-02-06"},
+		{"send it by Wednesday", "2026-02-11"},
+		{"follow up next Monday", "2026-02-09"},
+		{"follow up next Friday", "2026-02-13"},
+		{"wrap up by end of week", "2026-02-06"},
+		{"done by EOM", "2026-02-28"},
+		{"check back in 2 weeks", "2026-02-18"},
+		{"reply tomorrow", "2026-02-05"},
+		{"deliver by 2026-03-15", "2026-03-15"},
+		{"deliver by Jan 10", "2027-01-10"},
+		{"deliver by 3/1", "2026-03-01"},
+		{"invoice due 3/15", "2026-03-15"},
+	}
+
+	for _, tc := range cases {
+		due, _, ok := ResolveDeadline(tc.text, meeting)
+		if !ok {
+			t.Errorf("%q: expected deadline", tc.text)
+			continue
+		}
+		if got := due.Format("2006-01-02"); got != tc.expect {
+			t.Errorf("%q: expected %s, got %s", tc.text, tc.expect, got)
+		}
+	}
+
+	for _, text := range []string{"review the rollout plan", "cut 1/2 of the budget"} {
+		if _, _, ok := ResolveDeadline(text, meeting); ok {
+			t.Errorf("%q: expected no deadline", text)
+		}
+	}
+}
+
+func TestFilterByAssignee(t *testing.T) {
+	items := ParseSummary(testSummary, "2026-02-04")
+
+	mine := FilterByAssignee(items, "Tester McTest")
+	if len(mine) != 2 {
+		t.Fatalf("expected shared and direct items for first-name match, got %+v", mine)
+	}
+
+	if len(FilterByAssignee(items, "")) != len(items) {
+		t.Error("empty name should not filter")
+	}
+}
+
+func TestExportFormats(t *testing.T) {
+	items := ParseSummary(testSummary, "2026-02-04")
+	meeting := Meeting{Customer: "Acme", Date: "2026-02-04", SummaryPath: "/c/Acme/2026-02-04/summary.md"}
+	now := time.Date(2026, 2, 4, 12, 0, 0, 0, time.UTC)
+
+	t.Run("ics", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := Export(&buf, FormatICS, items, meeting, now); err != nil {
+			t.Fatalf("export failed: %v", err)
+		}
+		out := buf.String()
+		if strings.Count(out, "BEGIN:VTODO") != 4 {
+			t.Errorf("expected 4 VTODO entries:\n%s", out)
+		}
+		if !strings.Contains(out, "DUE;VALUE=DATE:20260206\r\n") {
+			t.Errorf("expected resolved due date:\n%s", out)
+		}
+		if !strings.Contains(out, `with notes\; by March 3.`) {
+			t.Errorf("expected escaped text:\n%s", out)
+		}
+		for _, line := range strings.Split(out, "\r\n") {
+			if len(line) > 75 {
+				t.Errorf("line exceeds 75 octets: %q", line)
+			}
+		}
+
+		var again bytes.Buffer
+		_ = Export(&again, FormatICS, items, meeting, now)
+		if again.String() != out {
+			t.Error("expected stable UIDs across exports")
+		}
+	})
+
+	t.Run("todotxt", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := Export(&buf, FormatTodoTxt, items, meeting, now); err != nil {
+			t.Fatalf("export failed: %v", err)
+		}
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		expected := "2026-02-04 Send the proposal document by Friday. +Acme @John_Doe due:2026-02-06 meeting:2026-02-04"
+		if lines[0] != expected {
+			t.Errorf("expected %q, got %q", expected, lines[0])
+		}
+		if !strings.Contains(lines[1], "@Jane_Smith @Tester") {
+			t.Errorf("expected one context per assignee, got %q", lines[1])
+		}
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := Export(&buf, FormatCSV, items, meeting, now); err != nil {
+			t.Fatalf("export failed: %v", err)
+		}
+		records, err := csv.NewReader(&buf).ReadAll()
+		if err != nil {
+			t.Fatalf("invalid csv: %v", err)
+		}
+		if len(records) != 5 || records[1][2] != "2026-02-06" || records[1][4] != "Acme" {
+			t.Errorf("unexpected csv records: %v", records)
+		}
+	})
+
+	t.Run("unknown format", func(t *testing.T) {
+		if err := Export(&bytes.Buffer{}, "xml", items, meeting, now); err == nil {
+			t.Error("expected error for unsupported format")
+		}
+		if err := ValidateFormat("xml"); err == nil || ValidateFormat(FormatCSV) != nil {
+			t.Errorf("unexpected format validation: %v", err)
+		}
+	})
+}
@@ -0,0 +1,205 @@
+package actions
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var weekdays = map[string]time.Weekday{
+	"sunday": time.Sunday, "sun": time.Sunday,
+	"monday": time.Monday, "mon": time.Monday,
+	"tuesday": time.Tuesday, "tue": time.Tuesday, "tues": time.Tuesday,
+	"wednesday": time.Wednesday, "wed": time.Wednesday,
+	"thursday": time.Thursday, "thu": time.Thursday, "thurs": time.Thursday,
+	"friday": time.Friday, "fri": time.Friday,
+	"saturday": time.Saturday, "sat": time.Saturday,
+}
+
+var months = map[string]time.Month{
+	"january": time.January, "jan": time.January,
+	"february": time.February, "feb": time.February,
+	"march": time.March, "mar": time.March,
+	"april": time.April, "apr": time.April,
+	"may":  time.May,
+	"june": time.June, "jun": time.June,
+	"july": time.July, "jul": time.July,
+	"august": time.August, "aug": time.August,
+	"september": time.September, "sep": time.September, "sept": time.September,
+	"october": time.October, "oct": time.October,
+	"november": time.November, "nov": time.November,
+	"december": time.December, "dec": time.December,
+}
+
+const (
+	weekdayPattern = `(sunday|monday|tuesday|wednesday|thursday|friday|saturday|sun|mon|tues|tue|wed|thurs|thu|fri|sat)`
+	monthPattern   = `(january|february|march|april|may|june|july|august|september|october|november|december|jan|feb|mar|apr|jun|jul|aug|sept|sep|oct|nov|dec)`
+)
+
+type deadlineRule struct {
+	re      *regexp.Regexp
+	resolve func(match []string, meeting time.Time) (time.Time, bool)
+}
+
+// deadlineRules are tried in order; the first match wins. More specific
+// phrases (ISO dates, "next Friday") come before general ones ("Friday").
+var deadlineRules = []deadlineRule{
+	{
+		re: regexp.MustCompile(`\b(\d{4}-\d{2}-\d{2})\b`),
+		resolve: func(m []string, _ time.Time) (time.Time, bool) {
+			t, err := time.Parse("2006-01-02", m[1])
+			return t, err == nil
+		},
+	},
+	{
+		re: regexp.MustCompile(`\b` + monthPattern + `\.?\s+(\d{1,2})(?:st|nd|rd|th)?\b`),
+		resolve: func(m []string, meeting time.Time) (time.Time, bool) {
+			day, _ := strconv.Atoi(m[2])
+			return upcomingDate(meeting, months[m[1]], day)
+		},
+	},
+	{
+		// Bare fractions such as "1/2 of the budget" are not deadlines
+		re: regexp.MustCompile(`\b(?:by|due|on|before|until)\s+(\d{1,2})/(\d{1,2})\b`),
+		resolve: func(m []string, meeting time.Time) (time.Time, bool) {
+			month, _ := strconv.Atoi(m[1])
+			day, _ := strconv.Atoi(m[2])
+			if month < 1 || month > 12 {
+				return time.Time{}, false
+			}
+			return upcomingDate(meeting, time.Month(month), day)
+		},
+	},
+	{
+		re: regexp.MustCompile(`\bnext\s+` + weekdayPattern + `\b`),
+		resolve: func(m []string, meeting time.Time) (time.Time, bool) {
+			due := nextWeekday(meeting, weekdays[m[1]])
+			if sameWeek(due, meeting) {
+				due = due.AddDate(0, 0, 7)
+			}
+			return due, true
+		},
+	},
+	{
+		re: regexp.MustCompile(`\b(?:by|before|on|this|until|due)\s+` + weekdayPattern + `\b`),
+		resolve: func(m []string, meeting time.Time) (time.Time, bool) {
+			return nextWeekday(meeting, weekdays[m[1]]), true
+		},
+	},
+	{
+		re: regexp.MustCompile(`\b(?:end of (?:the )?week|eow)\b`),
+		resolve: func(_ []string, meeting time.Time) (time.Time, bool) {
+			if meeting.Weekday() == time.Friday {
+				return meeting, true
+			}
+			return nextWeekday(meeting, time.Friday), true
+		},
+	},
+	{
+		re: regexp.MustCompile(`\bnext week\b`),
+		resolve: func(_ []string, meeting time.Time) (time.Time, bool) {
+			return nextWeekday(meeting, time.Friday).AddDate(0, 0, 7), true
+		},
+	},
+	{
+		re: regexp.MustCompile(`\b(?:end of (?:the )?month|eom)\b`),
+		resolve: func(_ []string, meeting time.Time) (time.Time, bool) {
+			return time.Date(meeting.Year(), meeting.Month()+1, 0, 0, 0, 0, 0, time.UTC), true
+		},
+	},
+	{
+		re: regexp.MustCompile(`\bnext month\b`),
+		resolve: func(_ []string, meeting time.Time) (time.Time, bool) {
+			return time.Date(meeting.Year(), meeting.Month()+2, 0, 0, 0, 0, 0, time.UTC), true
+		},
+	},
+	{
+		re: regexp.MustCompile(`\b(?:in|within)\s+(\d+|a|one|two|three)\s+(day|days|week|weeks)\b`),
+		resolve: func(m []string, meeting time.Time) (time.Time, bool) {
+			n := map[string]int{"a": 1, "one": 1, "two": 2, "three": 3}[m[1]]
+			if n == 0 {
+				n, _ = strconv.Atoi(m[1])
+			}
+			if strings.HasPrefix(m[2], "week") {
+				n *= 7
+			}
+			return meeting.AddDate(0, 0, n), n > 0
+		},
+	},
+	{
+		re: regexp.MustCompile(`\btomorrow\b`),
+		resolve: func(_ []string, meeting time.Time) (time.Time, bool) {
+			return meeting.AddDate(0, 0, 1), true
+		},
+	},
+	{
+		re: regexp.MustCompile(`\b(?:today|end of (?:the )?day|eod)\b`),
+		resolve: func(_ []string, meeting time.Time) (time.Time, bool) {
+			return meeting, true
+		},
+	},
+}
+
+// ResolveDeadline finds a deadline phrase in text and resolves it against the
+// meeting date. It returns the due date, the matched phrase and whether a
+// deadline was found.
+func ResolveDeadline(text string, meeting time.Time) (time.Time, string, bool) {
+	lower := strings.ToLower(text)
+	for _, rule := range deadlineRules {
+		match := rule.re.FindStringSubmatchIndex(lower)
+		if match == nil {
+			continue
+		}
+
+		groups := make([]string, 0, len(match)/2)
+		for i := 0; i < len(match); i += 2 {
+			if match[i] < 0 {
+				groups = append(groups, "")
+				continue
+			}
+			groups = append(groups, lower[match[i]:match[i+1]])
+		}
+
+		if due, ok := rule.resolve(groups, meeting); ok {
+			source := text
+			if len(source) != len(lower) {
+				source = lower
+			}
+			return due, strings.TrimSpace(source[match[0]:match[1]]), true
+		}
+	}
+	return time.Time{}, "", false
+}
+
+// nextWeekday returns the first occurrence of day strictly after from.
+func nextWeekday(from time.Time, day time.Weekday) time.Time {
+	delta := (int(day) - int(from.Weekday()) + 7) % 7
+	if delta == 0 {
+		delta = 7
+	}
+	return from.AddDate(0, 0, delta)
+}
+
+// sameWeek reports whether a and b fall in the same Monday-based week.
+func sameWeek(a, b time.Time) bool {
+	ay, aw := a.ISOWeek()
+	by, bw := b.ISOWeek()
+	return ay == by && aw == bw
+}
+
+// upcomingDate resolves a month/day without a year to the next occurrence on
+// or after the meeting date.
+func upcomingDate(meeting time.Time, month time.Month, day int) (time.Time, bool) {
+	if day < 1 || day > 31 {
+		return time.Time{}, false
+	}
+	candidate := time.Date(meeting.Year(), month, day, 0, 0, 0, 0, time.UTC)
+	if candidate.Month() != month {
+		return time.Time{}, false
+	}
+	if candidate.Before(meeting) {
+		candidate = candidate.AddDate(1, 0, 0)
+	}
+	return candidate, true
+}
@@ -0,0 +1,202 @@
+package actions
+
+import (
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Supported export formats.
+const (
+	FormatICS     = "ics"
+	FormatTodoTxt = "todotxt"
+	FormatCSV     = "csv"
+)
+
+// Formats lists the export formats in display order.
+var Formats = []string{FormatICS, FormatTodoTxt, FormatCSV}
+
+var todoTagRe = regexp.MustCompile(`[^A-Za-z0-9_.-]+`)
+
+// Export writes items to w in the requested format.
+func Export(w io.Writer, format string, items []Item, meeting Meeting, now time.Time) error {
+	switch format {
+	case FormatICS:
+		return writeICS(w, items, meeting, now)
+	case FormatTodoTxt:
+		return writeTodoTxt(w, items, meeting)
+	case FormatCSV:
+		return writeCSV(w, items, meeting)
+	default:
+		return ValidateFormat(format)
+	}
+}
+
+// ValidateFormat returns an error when format is not one of Formats.
+func ValidateFormat(format string) error {
+	if slices.Contains(Formats, format) {
+		return nil
+	}
+	return fmt.Errorf("unsupported export format %q; use one of: %s", format, strings.Join(Formats, ", "))
+}
+
+// ItemUID returns a stable identifier for an item so re-exports update rather
+// than duplicate tasks in calendar clients and trackers.
+func ItemUID(item Item, meeting Meeting) string {
+	sum := sha256.Sum256([]byte(strings.Join([]string{meeting.Customer, meeting.Date, item.Assignee, item.Task}, "\x00")))
+	return hex.EncodeToString(sum[:8])
+}
+
+func writeICS(w io.Writer, items []Item, meeting Meeting, now time.Time) error {
+	lines := []string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//meetsum//action items//EN",
+		"CALSCALE:GREGORIAN",
+	}
+
+	stamp := now.UTC().Format("20060102T150405Z")
+	for _, item := range items {
+		lines = append(lines,
+			"BEGIN:VTODO",
+			"UID:"+ItemUID(item, meeting)+"@meetsum",
+			"DTSTAMP:"+stamp,
+			"SUMMARY:"+icsEscape(taskTitle(item)),
+			"DESCRIPTION:"+icsEscape(Reference(item, meeting)),
+			"STATUS:NEEDS-ACTION",
+		)
+		if meeting.Customer != "" {
+			lines = append(lines, "CATEGORIES:"+icsEscape(meeting.Customer))
+		}
+		if item.HasDue() {
+			lines = append(lines, "DUE;VALUE=DATE:"+item.Due.Format("20060102"))
+		}
+		lines = append(lines, "END:VTODO")
+	}
+	lines = append(lines, "END:VCALENDAR")
+
+	var b strings.Builder
+	for _, line := range lines {
+		b.WriteString(icsFold(line))
+		b.WriteString("\r\n")
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func writeTodoTxt(w io.Writer, items []Item, meeting Meeting) error {
+	var b strings.Builder
+	for _, item := range items {
+		parts := []string{}
+		if meeting.Date != "" {
+			parts = append(parts, meeting.Date)
+		}
+		parts = append(parts, strings.Join(strings.Fields(item.Task), " "))
+		if tag := todoTag(meeting.Customer); tag != "" {
+			parts = append(parts, "+"+tag)
+		}
+		for _, assignee := range SplitAssignees(item.Assignee) {
+			if tag := todoTag(assignee); tag != "" {
+				parts = append(parts, "@"+tag)
+			}
+		}
+		if item.HasDue() {
+			parts = append(parts, "due:"+item.Due.Format("2006-01-02"))
+		}
+		if meeting.Date != "" {
+			parts = append(parts, "meeting:"+meeting.Date)
+		}
+		b.WriteString(strings.Join(parts, " "))
+		b.WriteString("\n")
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func writeCSV(w io.Writer, items []Item, meeting Meeting) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"assignee", "task", "due", "due_text", "customer", "meeting_date", "summary"}); err != nil {
+		return err
+	}
+	for _, item := range items {
+		due := ""
+		if item.HasDue() {
+			due = item.Due.Format("2006-01-02")
+		}
+		record := []string{item.Assignee, item.Task, due, item.DueText, meeting.Customer, meeting.Date, meeting.SummaryPath}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+func taskTitle(item Item) string {
+	if item.Assignee == "" {
+		return item.Task
+	}
+	return item.Assignee + ": " + item.Task
+}
+
+// Reference describes where an action item came from: assignee, customer,
+// meeting date, deadline phrase and summary file, one per line.
+func Reference(item Item, meeting Meeting) string {
+	var lines []string
+	if item.Assignee != "" {
+		lines = append(lines, "Assignee: "+item.Assignee)
+	}
+	if meeting.Customer != "" {
+		lines = append(lines, "Customer: "+meeting.Customer)
+	}
+	if meeting.Date != "" {
+		lines = append(lines, "Meeting: "+meeting.Date)
+	}
+	if item.DueText != "" {
+		lines = append(lines, "Deadline: "+item.DueText)
+	}
+	if meeting.SummaryPath != "" {
+		lines = append(lines, "Summary: "+filepath.ToSlash(meeting.SummaryPath))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func todoTag(value string) string {
+	return strings.Trim(todoTagRe.ReplaceAllString(value, "_"), "_")
+}
+
+func icsEscape(value string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+	return replacer.Replace(value)
+}
+
+// icsFold folds content lines longer than 75 octets per RFC 5545 without
+// splitting UTF-8 sequences.
+func icsFold(line string) string {
+	const limit = 75
+	if len(line) <= limit {
+		return line
+	}
+
+	var b strings.Builder
+	width := limit
+	for len(line) > width {
+		cut := width
+		for cut > 0 && line[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		width = limit - 1
+	}
+	b.WriteString(line)
+	return b.String()
+}
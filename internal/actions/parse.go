@@ -0,0 +1,131 @@
+package actions
+
+import (
+	"strings"
+	"time"
+
+	"github.com/bashfulrobot/meetsum/internal/summary"
+)
+
+// ActionItemsSection is the ParseSections key for the action items section.
+const ActionItemsSection = "action-items"
+
+// Item is a single action item parsed from a summary.
+type Item struct {
+	Assignee string
+	Task     string
+	DueText  string
+	Due      time.Time
+}
+
+// HasDue reports whether a concrete deadline was resolved for the item.
+func (i Item) HasDue() bool {
+	return !i.Due.IsZero()
+}
+
+// Meeting carries the references attached to exported tasks.
+type Meeting struct {
+	Customer    string
+	Date        string
+	SummaryPath string
+}
+
+// ParseSummary extracts action items from a full summary and resolves
+// relative deadlines against the meeting date (YYYY-MM-DD, may be empty).
+func ParseSummary(content, meetingDate string) []Item {
+	section, ok := summary.ParseSections(content)[ActionItemsSection]
+	if !ok {
+		return nil
+	}
+	return ParseSection(section, meetingDate)
+}
+
+// ParseSection parses "- Assignee: task" bullets from an action items section.
+// Bullets without an assignee prefix are kept with an empty assignee.
+func ParseSection(section, meetingDate string) []Item {
+	var reference time.Time
+	if meetingDate != "" {
+		if parsed, err := time.Parse("2006-01-02", meetingDate); err == nil {
+			reference = parsed
+		}
+	}
+
+	var items []Item
+	for _, line := range strings.Split(section, "\n") {
+		text, ok := bulletText(line)
+		if !ok {
+			continue
+		}
+
+		item := Item{Task: text}
+		if assignee, task, found := strings.Cut(text, ":"); found && looksLikeAssignee(assignee) {
+			item.Assignee = strings.TrimSpace(assignee)
+			item.Task = strings.TrimSpace(task)
+		}
+
+		if !reference.IsZero() {
+			if due, phrase, ok := ResolveDeadline(item.Task, reference); ok {
+				item.Due = due
+				item.DueText = phrase
+			}
+		}
+
+		items = append(items, item)
+	}
+
+	return items
+}
+
+// FilterByAssignee keeps items assigned to name. Matching is case-insensitive
+// and accepts the first name alone, since summaries often use short names.
+func FilterByAssignee(items []Item, name string) []Item {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return items
+	}
+
+	first := strings.Fields(name)[0]
+	var filtered []Item
+	for _, item := range items {
+		for _, assignee := range SplitAssignees(item.Assignee) {
+			if strings.EqualFold(assignee, name) || strings.EqualFold(assignee, first) {
+				filtered = append(filtered, item)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
+// SplitAssignees splits shared assignments such as "Jane & John" or "Jane, John".
+func SplitAssignees(assignee string) []string {
+	replacer := strings.NewReplacer(" and ", ",", "&", ",", "/", ",")
+	var names []string
+	for _, part := range strings.Split(replacer.Replace(assignee), ",") {
+		if trimmed := strings.TrimSpace(part); trimmed != "" {
+			names = append(names, trimmed)
+		}
+	}
+	return names
+}
+
+func bulletText(line string) (string, bool) {
+	trimmed := strings.TrimSpace(line)
+	for _, marker := range []string{"- ", "• ", "* "} {
+		if strings.HasPrefix(trimmed, marker) {
+			text := strings.TrimSpace(strings.TrimPrefix(trimmed, marker))
+			return text, text != ""
+		}
+	}
+	return "", false
+}
+
+// looksLikeAssignee guards against splitting tasks like "Note: ..." or
+// sentences that happen to contain a colon late in the text.
+func looksLikeAssignee(candidate string) bool {
+	candidate = strings.TrimSpace(candidate)
+	if candidate == "" || len(strings.Fields(candidate)) > 6 {
+		return false
+	}
+	return !strings.ContainsAny(candidate, ".!?()[]")
+}
@@ -0,0 +1,111 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bashfulrobot/meetsum/internal/ai"
+	"github.com/bashfulrobot/meetsum/internal/history"
+	"github.com/bashfulrobot/meetsum/internal/summary"
+	"github.com/charmbracelet/log"
+)
+
+// RestoreResult captures output from restoring an archived version.
+type RestoreResult struct {
+	Restored history.Version
+	// Archived is the version the replaced outputs were saved as; empty when
+	// the meeting had no outputs to replace.
+	Archived history.Version
+	Outputs  history.Outputs
+}
+
+// OutputPaths returns where the summary and Slack summary of a meeting
+// directory are saved under the current configuration.
+func (s *Service) OutputPaths(meetingDir string) (history.Outputs, error) {
+	meetingDir = strings.TrimSpace(meetingDir)
+	dirConfig, err := s.dirConfig(meetingDir)
+	if err != nil {
+		return history.Outputs{}, err
+	}
+	processor := summary.NewProcessor(dirConfig.Config, s.logger)
+	processor.SetUserName(dirConfig.Config.User.Name)
+	processor.SetMeetingDir(meetingDir)
+	return outputPaths(processor)
+}
+
+// RestoreVersion replaces the meeting's summary and Slack summary with an
+// archived version. The outputs being replaced are archived first, so a
+// restore can itself be undone.
+func (s *Service) RestoreVersion(meetingDir, ref string) (RestoreResult, error) {
+	meetingDir = strings.TrimSpace(meetingDir)
+	version, err := history.Find(meetingDir, ref)
+	if err != nil {
+		return RestoreResult{}, err
+	}
+	outputs, err := s.OutputPaths(meetingDir)
+	if err != nil {
+		return RestoreResult{}, err
+	}
+
+	archived, _, err := history.Restore(meetingDir, version, outputs)
+	if err != nil {
+		return RestoreResult{}, err
+	}
+	if s.logger != nil {
+		s.logger.Info("restored summary version", "version", version.ID, "archived", archived.ID)
+	}
+	return RestoreResult{Restored: version, Archived: archived, Outputs: outputs}, nil
+}
+
+func outputPaths(processor *summary.Processor) (history.Outputs, error) {
+	summaryPath, err := processor.SummaryPath()
+	if err != nil {
+		return history.Outputs{}, err
+	}
+	slackPath, err := processor.SlackSummaryPath()
+	if err != nil {
+		return history.Outputs{}, err
+	}
+	return history.Outputs{Summary: summaryPath, Slack: slackPath}, nil
+}
+
+// archiveOutputs saves the existing summary files to the meeting history
+// before they are overwritten.
+func archiveOutputs(processor *summary.Processor, meetingDir, reason string, logger *log.Logger) error {
+	outputs, err := outputPaths(processor)
+	if err != nil {
+		return err
+	}
+	version, archived, err := history.Archive(meetingDir, outputs, reason)
+	if err != nil {
+		return fmt.Errorf("failed to archive previous summary: %w", err)
+	}
+	if archived && logger != nil {
+		logger.Info("archived previous summary", "version", version.ID)
+	}
+	return nil
+}
+
+// recordGeneration notes which provider produced the saved summary so the
+// history shows it once the summary is archived. Failures are only logged.
+func recordGeneration(meetingDir, provider, source string, logger *log.Logger) {
+	err := history.RecordGeneration(meetingDir, history.Generation{
+		Provider:    provider,
+		GeneratedAt: time.Now().UTC(),
+		Source:      source,
+	})
+	if err != nil && logger != nil {
+		logger.Warn("failed to record summary generation", "error", err)
+	}
+}
+
+// configuredProvider returns the resolved AI command, or empty when the
+// configuration does not resolve.
+func configuredProvider(command string, args []string) string {
+	resolved, _, err := ai.ResolveConfiguredInvocation(command, args)
+	if err != nil {
+		return ""
+	}
+	return resolved
+}
@@ -0,0 +1,68 @@
+package app
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSessionPromptMatchesRun(t *testing.T) {
+	commandDir := t.TempDir()
+	stdinLog := filepath.Join(t.TempDir(), "stdin.log")
+	writeExecutable(t, commandDir, "fake-ai-capture", `#!/usr/bin/env bash
+cat > "${MEETSUM_STDIN_LOG}"
+printf '*_2026-02-04 ACME CADENCE CALL SUMMARY_*\n\n*HIGHLIGHTS*\n\n- Done.\n'
+`)
+	t.Setenv("PATH", commandDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+	t.Setenv("MEETSUM_STDIN_LOG", stdinLog)
+
+	cfg := newTestConfig(t, "fake-ai-capture")
+	meetingDir := createMeetingDir(t, "2026-02-04", "transcript.txt", "transcript content")
+	if err := os.WriteFile(filepath.Join(meetingDir, "pov-input.md"), []byte("point of view"), 0644); err != nil {
+		t.Fatalf("failed to write context: %v", err)
+	}
+
+	session, err := NewService(cfg, nil).Prepare(RunRequest{UserName: "Tester", MeetingDir: meetingDir})
+	if err != nil {
+		t.Fatalf("prepare failed: %v", err)
+	}
+
+	prompt, err := session.Prompt()
+	if err != nil {
+		t.Fatalf("prompt failed: %v", err)
+	}
+	if _, err := os.Stat(stdinLog); !os.IsNotExist(err) {
+		t.Fatal("building the prompt must not run the AI command")
+	}
+	entries, err := os.ReadDir(meetingDir)
+	if err != nil || len(entries) != 2 {
+		t.Fatalf("building the prompt must not touch the meeting directory, found %v", entries)
+	}
+
+	total := 0
+	sizes := map[string]int{}
+	for _, part := range prompt.Parts {
+		total += part.Size
+		sizes[part.Name] = part.Size
+	}
+	if total != prompt.Size || prompt.Size != len(prompt.Text) {
+		t.Errorf("parts add up to %d, prompt is %d bytes", total, len(prompt.Text))
+	}
+	if sizes["transcript"] != len("transcript content") || sizes["instructions"] != len("Meeting instructions") || sizes["context"] == 0 {
+		t.Errorf("unexpected part sizes %v", sizes)
+	}
+	if prompt.Tokens == 0 || prompt.Tokens > prompt.Size {
+		t.Errorf("unexpected token estimate %d for %d bytes", prompt.Tokens, prompt.Size)
+	}
+
+	if _, err := session.Run(); err != nil {
+		t.Fatalf("run failed: %v", err)
+	}
+	sent, err := os.ReadFile(stdinLog)
+	if err != nil {
+		t.Fatalf("failed to read captured prompt: %v", err)
+	}
+	if string(sent) != prompt.Text {
+		t.Errorf("prompt sent by Run differs from the dry-run prompt")
+	}
+}
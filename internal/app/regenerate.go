@@ -0,0 +1,112 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bashfulrobot/meetsum/internal/summary"
+)
+
+// RegenerateRequest selects one section of an existing summary to rewrite.
+type RegenerateRequest struct {
+	UserName    string
+	MeetingDir  string
+	MeetingType string
+	Section     string
+}
+
+// RegenerateResult captures output from a section regeneration.
+type RegenerateResult struct {
+	Section         string
+	Content         string
+	Summary         string
+	OutputPath      string
+	SlackOutputPath string
+	SlackWarning    string
+	LintIssues      []summary.LintIssue
+	// RecordingURL is the link filled into a recording placeholder, if any.
+	RecordingURL     string
+	RecordingWarning string
+}
+
+// RegenerateSection re-prompts the AI for one section of the saved summary,
+// splices it back in place and rebuilds the Slack summary. The spliced summary
+// is linted and has its recording link filled like a full run; other sections
+// are otherwise left untouched.
+func (s *Service) RegenerateSection(request RegenerateRequest) (RegenerateResult, error) {
+	userName := strings.TrimSpace(request.UserName)
+	if userName == "" {
+		return RegenerateResult{}, fmt.Errorf("user name is required")
+	}
+	key := summary.NormalizeSectionKey(request.Section)
+	if key == "" {
+		return RegenerateResult{}, fmt.Errorf("section is required")
+	}
+
+	meetingDir := strings.TrimSpace(request.MeetingDir)
+	dirConfig, err := s.dirConfig(meetingDir)
+	if err != nil {
+		return RegenerateResult{}, err
+	}
+	cfg := dirConfig.Config
+
+	processor := summary.NewProcessor(cfg, s.logger)
+	processor.SetUserName(userName)
+	processor.SetMeetingDir(meetingDir)
+	processor.SetMeetingType(request.MeetingType)
+	if err := processor.ValidateRequiredFiles(); err != nil {
+		return RegenerateResult{}, err
+	}
+
+	content, _, err := processor.LoadSavedSummary()
+	if err != nil {
+		return RegenerateResult{}, err
+	}
+
+	section, err := processor.RegenerateSection(content, key)
+	if err != nil {
+		return RegenerateResult{}, &stageError{stage: ErrGeneration, err: err}
+	}
+
+	updated, err := summary.ReplaceSection(content, key, section)
+	if err != nil {
+		return RegenerateResult{}, err
+	}
+	if err := processor.ValidateSummaryContent(updated); err != nil {
+		return RegenerateResult{}, &stageError{stage: ErrInvalidSummary, err: err}
+	}
+
+	updated, lintIssues := lintForSave(cfg, updated)
+	updated, recordingURL, recordingWarning := fillRecordingURL(processor, cfg, meetingDir, s.logger, updated)
+
+	if err := archiveOutputs(processor, meetingDir, "regenerate", s.logger); err != nil {
+		return RegenerateResult{}, err
+	}
+	outputPath, err := processor.SaveSummary(updated)
+	if err != nil {
+		return RegenerateResult{}, err
+	}
+	recordGeneration(meetingDir, configuredProvider(cfg.AI.Command, cfg.AI.Args), "regenerate", s.logger)
+
+	result := RegenerateResult{
+		Section:          key,
+		Content:          section,
+		Summary:          updated,
+		OutputPath:       outputPath,
+		LintIssues:       lintIssues,
+		RecordingURL:     recordingURL,
+		RecordingWarning: recordingWarning,
+	}
+
+	slackPath, err := processor.SaveSlackSummary(processor.BuildSlackSummary(updated))
+	if err != nil {
+		result.SlackWarning = err.Error()
+	} else {
+		result.SlackOutputPath = slackPath
+	}
+
+	if s.logger != nil {
+		s.logger.Info("regenerated summary section", "section", key, "summary", outputPath)
+	}
+	return result, nil
+}
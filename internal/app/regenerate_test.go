@@ -0,0 +1,148 @@
+package app
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/bashfulrobot/meetsum/internal/history"
+)
+
+func TestServiceRegenerateSection(t *testing.T) {
+	commandDir := t.TempDir()
+	writeExecutable(t, commandDir, "fake-ai-section", `#!/usr/bin/env bash
+cat >/dev/null
+cat <<'OUT'
+Here you go:
+*ACTION ITEMS*
+
+- Tester: Send the corrected pricing sheet by Friday
+OUT
+`)
+	t.Setenv("PATH", commandDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	cfg := newTestConfig(t, "fake-ai-section")
+	meetingDir := createMeetingDir(t, "2026-02-04", "transcript.txt", "transcript content")
+	original := `*_2026-02-04 ACME CADENCE CALL SUMMARY_*
+
+*HIGHLIGHTS*
+
+- Acme renewed.
+
+*ACTION ITEMS*
+
+- Tester: Send the wrong thing
+
+*RISKS*
+
+- None noted.`
+	summaryPath := filepath.Join(meetingDir, "2026-02-04-Acme-cadence-call-summary.md")
+	if err := os.WriteFile(summaryPath, []byte(original), 0644); err != nil {
+		t.Fatalf("failed to write summary: %v", err)
+	}
+
+	service := NewService(cfg, nil)
+	result, err := service.RegenerateSection(RegenerateRequest{UserName: "Tester", MeetingDir: meetingDir, Section: "Action Items"})
+	if err != nil {
+		t.Fatalf("regenerate failed: %v", err)
+	}
+
+	saved, err := os.ReadFile(summaryPath)
+	if err != nil {
+		t.Fatalf("failed to read summary: %v", err)
+	}
+	want := strings.Replace(original, "- Tester: Send the wrong thing", "- Tester: Send the corrected pricing sheet by Friday", 1)
+	if string(saved) != want+"\n" || result.Summary != string(saved) {
+		t.Errorf("unexpected summary after regenerate:\n%s", saved)
+	}
+
+	slack, err := os.ReadFile(result.SlackOutputPath)
+	if err != nil {
+		t.Fatalf("expected a rebuilt Slack summary: %v", err)
+	}
+	if !strings.Contains(string(slack), "corrected pricing sheet") || !strings.Contains(string(slack), "Acme renewed") {
+		t.Errorf("unexpected Slack summary:\n%s", slack)
+	}
+
+	versions, err := history.List(meetingDir)
+	if err != nil || len(versions) != 1 {
+		t.Fatalf("expected the previous summary to be archived, got %v (%v)", versions, err)
+	}
+	archived, err := os.ReadFile(versions[0].SummaryPath())
+	if err != nil || string(archived) != original || versions[0].Reason != "regenerate" {
+		t.Errorf("unexpected archived version %+v:\n%s", versions[0], archived)
+	}
+	if generation := history.LoadGeneration(meetingDir); generation.Provider != "fake-ai-section" || generation.Source != "regenerate" {
+		t.Errorf("unexpected recorded generation: %+v", generation)
+	}
+
+	if _, err := service.RegenerateSection(RegenerateRequest{UserName: "Tester", MeetingDir: meetingDir, Section: "next-steps"}); err == nil || !strings.Contains(err.Error(), "available: action-items") {
+		t.Errorf("expected an error listing available sections, got %v", err)
+	}
+}
+
+func TestServiceRegenerateSectionMatchesRun(t *testing.T) {
+	commandDir := t.TempDir()
+	promptPath := filepath.Join(t.TempDir(), "prompt.txt")
+	writeExecutable(t, commandDir, "fake-ai-messy-section", `#!/usr/bin/env bash
+cat >"`+promptPath+`"
+cat <<'OUT'
+*ACTION ITEMS*
+• Tester: Send the **pricing** sheet
+OUT
+`)
+	t.Setenv("PATH", commandDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	cfg := newTestConfig(t, "fake-ai-messy-section")
+	cfg.Features.LintFix = true
+	meetingDir := createMeetingDir(t, "2026-02-04", "transcript.txt", "transcript content")
+	files := map[string]string{
+		cfg.Files.PovInput: "point of view",
+		"invite.ics":       "BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART;VALUE=DATE:20260204\nSUMMARY:Acme cadence\nATTENDEE;CN=Sam Lee:mailto:sam@acme.example.com\nEND:VEVENT\nEND:VCALENDAR\n",
+		"meeting.yaml":     "recording_url: https://zoom.example.com/rec/abc\n",
+		"2026-02-04-Acme-cadence-call-summary.md": `*_2026-02-04 ACME CADENCE CALL SUMMARY_*
+
+*ACTION ITEMS*
+
+- Tester: Send the wrong thing
+
+*MEETING RECORDING*
+
+- [Meeting Recording](PLACEHOLDER_URL)
+`,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(meetingDir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+	}
+
+	result, err := NewService(cfg, nil).RegenerateSection(RegenerateRequest{UserName: "Tester", MeetingDir: meetingDir, Section: "action-items"})
+	if err != nil {
+		t.Fatalf("regenerate failed: %v", err)
+	}
+
+	prompt, err := os.ReadFile(promptPath)
+	if err != nil {
+		t.Fatalf("failed to read prompt: %v", err)
+	}
+	for _, want := range []string{"CONTEXT GUIDE:\npoint of view", "MEETING INVITE (invite.ics):", "- Sam Lee <sam@acme.example.com>"} {
+		if !strings.Contains(string(prompt), want) {
+			t.Errorf("expected regenerate prompt to contain %q:\n%s", want, prompt)
+		}
+	}
+
+	saved, err := os.ReadFile(result.OutputPath)
+	if err != nil {
+		t.Fatalf("failed to read summary: %v", err)
+	}
+	for _, want := range []string{"*ACTION ITEMS*\n\n- Tester: Send the *pricing* sheet", "[Meeting Recording](https://zoom.example.com/rec/abc)"} {
+		if !strings.Contains(string(saved), want) {
+			t.Errorf("expected saved summary to contain %q:\n%s", want, saved)
+		}
+	}
+	if result.RecordingURL != "https://zoom.example.com/rec/abc" || result.RecordingWarning != "" {
+		t.Errorf("unexpected recording result: %q / %q", result.RecordingURL, result.RecordingWarning)
+	}
+}
@@ -1,27 +1,45 @@
 package app
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/bashfulrobot/meetsum/config"
+	"github.com/bashfulrobot/meetsum/internal/actions"
 	"github.com/bashfulrobot/meetsum/internal/ai"
+	"github.com/bashfulrobot/meetsum/internal/email"
+	"github.com/bashfulrobot/meetsum/internal/gitrepo"
+	"github.com/bashfulrobot/meetsum/internal/notes"
+	"github.com/bashfulrobot/meetsum/internal/slack"
 	"github.com/bashfulrobot/meetsum/internal/summary"
+	"github.com/bashfulrobot/meetsum/internal/webhook"
 	"github.com/charmbracelet/log"
 )
 
 // RunRequest captures runtime inputs collected by CLI handlers.
 type RunRequest struct {
-	UserName   string
-	MeetingDir string
+	UserName     string
+	MeetingDir   string
+	MeetingType  string
+	RecordingURL string
+	// TraceMode and WriteEmail override features.trace_mode and email.enabled
+	// when set. They win over .meetsum.yaml overrides, like any CLI flag.
+	TraceMode  *bool
+	WriteEmail *bool
 }
 
 // RunPreparation captures validated runtime context for CLI rendering.
 type RunPreparation struct {
 	MeetingDir     string
+	MeetingType    string
 	TranscriptFile string
 	OptionalFiles  []string
+	// ConfigFiles are the .meetsum.yaml overrides applied, customer folder first.
+	ConfigFiles []string
 }
 
 // RunResult captures output from a runtime summary execution.
@@ -32,8 +50,87 @@ type RunResult struct {
 	RenamedTranscript string
 	RenameWarning     string
 	SlackWarning      string
+	SlackPermalink    string
+	SlackPostWarning  string
+	NoteOutputPath    string
+	NoteWarning       string
+	EmailOutputPath   string
+	EmailWarning      string
+	LintIssues        []summary.LintIssue
+	RecordingURL      string
+	RecordingWarning  string
+	GitCommit         string
+	GitWarning        string
+	WebhookWarning    string
 }
 
+// ErrGeneration marks runs where the AI provider failed to produce output.
+var ErrGeneration = errors.New("summary generation failed")
+
+// ErrInvalidSummary marks runs where the provider output failed validation.
+var ErrInvalidSummary = errors.New("generated summary failed validation")
+
+// stageError tags err with the pipeline stage that failed without changing
+// its message.
+type stageError struct {
+	stage error
+	err   error
+}
+
+func (e *stageError) Error() string { return e.err.Error() }
+
+func (e *stageError) Unwrap() error { return e.err }
+
+func (e *stageError) Is(target error) bool { return target == e.stage }
+
+// Warnings returns the non-fatal problems of a run as display lines.
+func (r RunResult) Warnings() []string {
+	warnings := []struct{ label, text string }{
+		{"Could not rename transcript", r.RenameWarning},
+		{"Could not save Slack summary", r.SlackWarning},
+		{"Webhook delivery failed", r.WebhookWarning},
+		{"Git auto-commit", r.GitWarning},
+		{"", r.RecordingWarning},
+		{"Could not create follow-up email", r.EmailWarning},
+		{"Could not write note", r.NoteWarning},
+		{"Could not post to Slack", r.SlackPostWarning},
+	}
+
+	var lines []string
+	for _, warning := range warnings {
+		switch {
+		case warning.text == "":
+		case warning.label == "":
+			lines = append(lines, warning.text)
+		default:
+			lines = append(lines, warning.label+": "+warning.text)
+		}
+	}
+	return lines
+}
+
+// SlackPostRecord is persisted to the meeting state directory after a Slack delivery.
+type SlackPostRecord struct {
+	Channel   string    `json:"channel,omitempty"`
+	TS        string    `json:"ts,omitempty"`
+	Permalink string    `json:"permalink,omitempty"`
+	Replies   int       `json:"replies"`
+	Threaded  bool      `json:"threaded"`
+	PostedAt  time.Time `json:"posted_at"`
+}
+
+// slackPostRecordFile is the state file name for SlackPostRecord.
+const slackPostRecordFile = "slack-post.json"
+
+// webhookTimeout bounds delivery to all webhook URLs, including retries.
+const webhookTimeout = 90 * time.Second
+
+// gitTimeout bounds the post-run git commit and optional push.
+const gitTimeout = 2 * time.Minute
+
+// slackPostTimeout bounds the full Slack delivery (parent, replies, permalink).
+const slackPostTimeout = 60 * time.Second
+
 // Service orchestrates summary runtime behavior independently of CLI rendering.
 type Service struct {
 	cfg    *config.Config
@@ -42,6 +139,8 @@ type Service struct {
 
 // Session encapsulates a prepared runtime execution.
 type Session struct {
+	cfg         *config.Config
+	logger      *log.Logger
 	processor   *summary.Processor
 	preparation RunPreparation
 }
@@ -56,6 +155,29 @@ func (s *Service) Preflight() (string, error) {
 	return ai.CheckConfiguredCommandAvailable(s.cfg.AI.Command, s.cfg.AI.Args)
 }
 
+// PreflightDir checks the AI command configured for meetingDir, including
+// its .meetsum.yaml overrides.
+func (s *Service) PreflightDir(meetingDir string) (string, error) {
+	dirConfig, err := s.dirConfig(meetingDir)
+	if err != nil {
+		return "", err
+	}
+	return ai.CheckConfiguredCommandAvailable(dirConfig.Config.AI.Command, dirConfig.Config.AI.Args)
+}
+
+// dirConfig returns the configuration for meetingDir with its .meetsum.yaml
+// overrides merged in.
+func (s *Service) dirConfig(meetingDir string) (*config.DirConfig, error) {
+	dirConfig, err := s.cfg.ForDir(meetingDir)
+	if err != nil {
+		return nil, err
+	}
+	if len(dirConfig.Files) > 0 && s.logger != nil {
+		s.logger.Debug("applied directory configuration", "files", dirConfig.Files)
+	}
+	return dirConfig, nil
+}
+
 // Prepare validates runtime inputs and required files.
 func (s *Service) Prepare(request RunRequest) (*Session, error) {
 	userName := strings.TrimSpace(request.UserName)
@@ -68,9 +190,33 @@ func (s *Service) Prepare(request RunRequest) (*Session, error) {
 		return nil, fmt.Errorf("meeting directory is required")
 	}
 
-	processor := summary.NewProcessor(s.cfg, s.logger)
+	dirConfig, err := s.dirConfig(meetingDir)
+	if err != nil {
+		return nil, err
+	}
+	cfg := dirConfig.Config
+	if request.TraceMode != nil || request.WriteEmail != nil {
+		flagged := *cfg
+		if request.TraceMode != nil {
+			flagged.Features.TraceMode = *request.TraceMode
+		}
+		if request.WriteEmail != nil {
+			flagged.Email.Enabled = *request.WriteEmail
+		}
+		cfg = &flagged
+	}
+
+	processor := summary.NewProcessor(cfg, s.logger)
 	processor.SetUserName(userName)
 	processor.SetMeetingDir(meetingDir)
+	processor.SetMeetingType(request.MeetingType)
+
+	if recordingURL := strings.TrimSpace(request.RecordingURL); recordingURL != "" {
+		if err := summary.ValidateRecordingURL(recordingURL); err != nil {
+			return nil, err
+		}
+		processor.SetRecordingURL(recordingURL)
+	}
 
 	if err := processor.ValidateRequiredFiles(); err != nil {
 		return nil, err
@@ -78,51 +224,98 @@ func (s *Service) Prepare(request RunRequest) (*Session, error) {
 
 	preparation := RunPreparation{
 		MeetingDir:     meetingDir,
+		MeetingType:    processor.MeetingType(),
 		TranscriptFile: filepath.Base(processor.TranscriptPath()),
 		OptionalFiles:  processor.GetOptionalFiles(),
+		ConfigFiles:    dirConfig.Files,
 	}
 
 	return &Session{
+		cfg:         cfg,
+		logger:      s.logger,
 		processor:   processor,
 		preparation: preparation,
 	}, nil
 }
 
+// Preflight checks the AI command the session will run, which a
+// .meetsum.yaml override may have changed.
+func (s *Session) Preflight() (string, error) {
+	return ai.CheckConfiguredCommandAvailable(s.cfg.AI.Command, s.cfg.AI.Args)
+}
+
+// Config returns the configuration in effect for the session's meeting
+// directory.
+func (s *Session) Config() *config.Config {
+	return s.cfg
+}
+
 // Preparation returns session context suitable for CLI display.
 func (s *Session) Preparation() RunPreparation {
 	return s.preparation
 }
 
-// Run executes summary generation and persistence.
+// Prompt assembles the exact summary prompt Run would send, without calling
+// the AI command or writing any files.
+func (s *Session) Prompt() (summary.Prompt, error) {
+	return s.processor.BuildPrompt()
+}
+
+// Run executes summary generation and persistence, then notifies configured
+// webhooks of the outcome.
 func (s *Session) Run() (RunResult, error) {
+	started := time.Now()
+	result, err := s.run()
+
+	if len(s.cfg.Webhooks.URLs) > 0 {
+		if webhookErr := s.sendWebhook(result, err, time.Since(started)); webhookErr != nil {
+			result.WebhookWarning = webhookErr.Error()
+			if s.logger != nil {
+				s.logger.Warn("webhook delivery failed", "error", webhookErr)
+			}
+		}
+	}
+
+	return result, err
+}
+
+func (s *Session) run() (RunResult, error) {
 	output, err := s.processor.GenerateSummaryOutput()
 	if err != nil {
-		return RunResult{}, err
+		return RunResult{}, &stageError{stage: ErrGeneration, err: err}
 	}
 
 	if err := s.processor.ValidateSummaryContent(output.Cleaned); err != nil {
 		diagnosticPath, saveErr := s.processor.SaveRawOutputDiagnostics(output.Raw)
 		if saveErr != nil {
-			return RunResult{}, fmt.Errorf("%w; also failed to save diagnostics: %w", err, saveErr)
+			return RunResult{}, &stageError{stage: ErrInvalidSummary, err: fmt.Errorf("%w; also failed to save diagnostics: %w", err, saveErr)}
 		}
 
-		return RunResult{}, fmt.Errorf(
+		return RunResult{}, &stageError{stage: ErrInvalidSummary, err: fmt.Errorf(
 			"%w; raw provider output saved to %s",
 			err,
 			diagnosticPath,
-		)
+		)}
 	}
 
-	outputPath, err := s.processor.SaveSummary(output.Cleaned)
+	content, lintIssues := lintForSave(s.cfg, output.Cleaned)
+
+	// Fill the recording link before saving so the Slack summary inherits it
+	content, recordingURL, recordingWarning := fillRecordingURL(s.processor, s.cfg, s.preparation.MeetingDir, s.logger, content)
+
+	if err := archiveOutputs(s.processor, s.preparation.MeetingDir, "run", s.logger); err != nil {
+		return RunResult{}, err
+	}
+	outputPath, err := s.processor.SaveSummary(content)
 	if err != nil {
 		return RunResult{}, err
 	}
+	recordGeneration(s.preparation.MeetingDir, s.Provider(), "run", s.logger)
 
 	// Generate and save Slack mini summary (non-fatal)
 	slackOutputPath := ""
 	slackWarning := ""
-	sections := summary.ParseSections(output.Cleaned)
-	slackContent := summary.BuildSlackSummary(sections)
+	slackContent := s.processor.BuildSlackSummary(content)
 	slackPath, slackErr := s.processor.SaveSlackSummary(slackContent)
 	if slackErr != nil {
 		slackWarning = slackErr.Error()
@@ -130,18 +323,323 @@ func (s *Session) Run() (RunResult, error) {
 		slackOutputPath = slackPath
 	}
 
+	// Generate follow-up email draft when enabled (non-fatal)
+	emailOutputPath := ""
+	emailWarning := ""
+	if s.cfg.Email.Enabled {
+		emailPath, emailErr := s.writeFollowUpEmail(content)
+		if emailErr != nil {
+			emailWarning = emailErr.Error()
+		} else {
+			emailOutputPath = emailPath
+		}
+	}
+
+	// Deliver to Slack when enabled (non-fatal)
+	slackPermalink := ""
+	slackPostWarning := ""
+	if s.cfg.Slack.Enabled {
+		permalink, postErr := s.postToSlack(slackContent, content)
+		if postErr != nil {
+			slackPostWarning = postErr.Error()
+		}
+		slackPermalink = permalink
+	}
+
+	originalTranscript := s.processor.TranscriptPath()
 	renamedTranscript, err := s.processor.RenameTranscriptFile()
 	renameWarning := ""
 	if err != nil {
 		renameWarning = err.Error()
 	}
 
+	// Write knowledge base note when enabled (non-fatal)
+	noteOutputPath := ""
+	noteWarning := ""
+	if s.cfg.Notes.Enabled {
+		notePath, noteErr := s.writeNote(content, outputPath)
+		if noteErr != nil {
+			noteWarning = noteErr.Error()
+		} else {
+			noteOutputPath = notePath
+		}
+	}
+
+	// Commit outputs into the customer repository when enabled (non-fatal)
+	gitCommit := ""
+	gitWarning := ""
+	if s.cfg.Git.AutoCommit {
+		paths := []string{outputPath, slackOutputPath, emailOutputPath}
+		if renamedTranscript != "" {
+			paths = append(paths, originalTranscript, s.processor.TranscriptPath())
+		}
+		commit, gitErr := s.commitOutputs(paths)
+		if gitErr != nil {
+			gitWarning = gitErr.Error()
+		}
+		gitCommit = commit
+	}
+
 	return RunResult{
-		Summary:           output.Cleaned,
+		Summary:           content,
 		OutputPath:        outputPath,
 		SlackOutputPath:   slackOutputPath,
 		RenamedTranscript: renamedTranscript,
 		RenameWarning:     renameWarning,
 		SlackWarning:      slackWarning,
+		SlackPermalink:    slackPermalink,
+		SlackPostWarning:  slackPostWarning,
+		NoteOutputPath:    noteOutputPath,
+		NoteWarning:       noteWarning,
+		EmailOutputPath:   emailOutputPath,
+		EmailWarning:      emailWarning,
+		LintIssues:        lintIssues,
+		RecordingURL:      recordingURL,
+		RecordingWarning:  recordingWarning,
+		GitCommit:         gitCommit,
+		GitWarning:        gitWarning,
 	}, nil
 }
+
+// commitOutputs commits the run's files in the repository containing the
+// meeting directory and pushes when configured. Meeting directories outside a
+// repository, or runs that changed nothing, are skipped without a warning.
+func (s *Session) commitOutputs(paths []string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), gitTimeout)
+	defer cancel()
+
+	repo, err := gitrepo.Open(ctx, s.preparation.MeetingDir)
+	if errors.Is(err, gitrepo.ErrNotRepository) {
+		if s.logger != nil {
+			s.logger.Info("skipping git commit: meeting directory is not in a git repository")
+		}
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	commit, err := repo.CommitPaths(ctx, paths, s.gitCommitMessage())
+	if errors.Is(err, gitrepo.ErrNothingToCommit) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("git commit failed: %w", err)
+	}
+
+	if s.cfg.Git.Push {
+		remote := strings.TrimSpace(s.cfg.Git.Remote)
+		if remote == "" {
+			remote = "origin"
+		}
+		if err := repo.Push(ctx, remote); err != nil {
+			return commit, fmt.Errorf("committed %s but push to %s failed: %w", commit, remote, err)
+		}
+	}
+
+	return commit, nil
+}
+
+// sendWebhook posts a signed run event to every configured webhook URL.
+func (s *Session) sendWebhook(result RunResult, runErr error, duration time.Duration) error {
+	customer, _ := s.processor.ExtractCustomerName()
+	event := webhook.Event{
+		Event:       webhook.EventRunSucceeded,
+		Timestamp:   time.Now().UTC(),
+		Customer:    customer,
+		Date:        s.processor.ExtractDateFromPath(),
+		MeetingType: s.preparation.MeetingType,
+		MeetingDir:  s.preparation.MeetingDir,
+		Provider:    s.Provider(),
+		DurationMS:  duration.Milliseconds(),
+	}
+
+	if runErr != nil {
+		event.Event = webhook.EventRunFailed
+		event.Error = runErr.Error()
+	} else {
+		event.Outputs = webhook.Outputs{
+			Summary:      result.OutputPath,
+			SlackSummary: result.SlackOutputPath,
+			Transcript:   s.processor.TranscriptPath(),
+			Email:        result.EmailOutputPath,
+			Note:         result.NoteOutputPath,
+		}
+		event.Highlights = summary.SectionBullets(summary.ParseSections(result.Summary)["highlights"])
+		for _, item := range actions.ParseSummary(result.Summary, event.Date) {
+			actionItem := webhook.ActionItem{Assignee: item.Assignee, Task: item.Task}
+			if item.HasDue() {
+				actionItem.Due = item.Due.Format("2006-01-02")
+			}
+			event.ActionItems = append(event.ActionItems, actionItem)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), webhookTimeout)
+	defer cancel()
+
+	sender := webhook.NewSender(s.cfg.Webhooks.Secret, s.cfg.Webhooks.Attempts)
+	return sender.Send(ctx, s.cfg.Webhooks.URLs, event)
+}
+
+// gitCommitMessage expands git.message_template, falling back to the default
+// template when it expands to nothing.
+func (s *Session) gitCommitMessage() string {
+	vars := s.processor.TemplateVars()
+	vars["provider"] = s.Provider()
+	if message := summary.ExpandText(s.cfg.Git.MessageTemplate, vars); message != "" {
+		return message
+	}
+	return summary.ExpandText(config.DefaultGitMessageTemplate, vars)
+}
+
+// lintForSave auto-fixes mechanical formatting issues when enabled; remaining
+// issues are reported against the content as it will be saved.
+func lintForSave(cfg *config.Config, content string) (string, []summary.LintIssue) {
+	content = summary.SavedContent(content)
+	if cfg.Features.LintFix {
+		return summary.FixSummary(content)
+	}
+	return content, summary.LintSummary(content)
+}
+
+// fillRecordingURL substitutes the recording placeholder in content. Returns
+// the updated content, the link used and a warning when the placeholder could
+// not be filled.
+func fillRecordingURL(processor *summary.Processor, cfg *config.Config, meetingDir string, logger *log.Logger, content string) (filled, link, warning string) {
+	if !summary.HasRecordingPlaceholder(content) {
+		return content, "", ""
+	}
+
+	link, source, err := processor.RecordingURL()
+	if err != nil {
+		return content, "", fmt.Sprintf("Recording link placeholder left in summary: %v", err)
+	}
+	if link == "" {
+		return content, "", fmt.Sprintf("Recording link placeholder left in summary: add recording_url to %s, a .url/.webloc file, or pass --recording-url",
+			filepath.Base(cfg.GetMetadataPath(meetingDir)))
+	}
+
+	if logger != nil {
+		logger.Info("filled recording link", "source", source)
+	}
+	return summary.FillRecordingPlaceholder(content, link), link, ""
+}
+
+// Provider returns the resolved AI command used for generation.
+func (s *Session) Provider() string {
+	return configuredProvider(s.cfg.AI.Command, s.cfg.AI.Args)
+}
+
+// writeNote stores the summary as a knowledge base note with YAML frontmatter.
+func (s *Session) writeNote(content, summaryPath string) (string, error) {
+	customer, _ := s.processor.ExtractCustomerName()
+	date := s.processor.ExtractDateFromPath()
+	items := actions.ParseSummary(content, date)
+
+	attendees := s.processor.LoadAttendees()
+	if len(attendees) == 0 {
+		attendees = actionItemAssignees(items)
+	}
+
+	meta := notes.Metadata{
+		Customer:         customer,
+		Date:             date,
+		Attendees:        attendees,
+		Tags:             s.cfg.Notes.Tags,
+		Provider:         s.Provider(),
+		SourceTranscript: filepath.Base(s.processor.TranscriptPath()),
+		SummaryFile:      summaryPath,
+		ActionItems:      len(items),
+	}
+
+	return notes.Write(notes.Options{
+		VaultDir:         s.cfg.GetNotesVaultDir(),
+		FilenameTemplate: s.cfg.Notes.FilenameTemplate,
+		CustomerPage:     s.cfg.Notes.CustomerPage,
+	}, meta, content)
+}
+
+// writeFollowUpEmail generates the customer-facing follow-up email and saves it
+// as an .eml draft in the meeting directory.
+func (s *Session) writeFollowUpEmail(summaryContent string) (string, error) {
+	body, err := s.processor.GenerateFollowUpEmail(summaryContent)
+	if err != nil {
+		return "", err
+	}
+
+	message, err := email.Build(email.Draft{
+		From:    s.cfg.Email.From,
+		To:      s.cfg.Email.To,
+		Cc:      s.cfg.Email.Cc,
+		Subject: s.processor.FollowUpEmailSubject(),
+		Body:    body,
+		Date:    time.Now(),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return s.processor.SaveFollowUpEmail(message)
+}
+
+// actionItemAssignees returns unique assignee names in first-seen order.
+func actionItemAssignees(items []actions.Item) []string {
+	seen := make(map[string]bool)
+	var names []string
+	for _, item := range items {
+		for _, name := range actions.SplitAssignees(item.Assignee) {
+			key := strings.ToLower(name)
+			if !seen[key] {
+				seen[key] = true
+				names = append(names, name)
+			}
+		}
+	}
+	return names
+}
+
+// postToSlack delivers the mini summary and threaded full summary to the
+// customer's Slack target and records the result in the meeting state directory.
+func (s *Session) postToSlack(mini, full string) (string, error) {
+	customer, _ := s.processor.ExtractCustomerName()
+	target := s.cfg.GetSlackTarget(customer)
+
+	client := slack.NewClient(slack.Target{
+		WebhookURL: target.WebhookURL,
+		BotToken:   target.BotToken,
+		Channel:    target.Channel,
+		APIURL:     s.cfg.Slack.APIURL,
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), slackPostTimeout)
+	defer cancel()
+
+	result, postErr := client.PostSummary(ctx, mini, full)
+	if postErr != nil && result.TS == "" {
+		return "", postErr
+	}
+
+	record := SlackPostRecord{
+		Channel:   result.Channel,
+		TS:        result.TS,
+		Permalink: result.Permalink,
+		Replies:   result.Replies,
+		Threaded:  result.Threaded,
+		PostedAt:  time.Now(),
+	}
+	if _, err := summary.WriteStateFile(s.preparation.MeetingDir, slackPostRecordFile, record); err != nil {
+		if postErr != nil {
+			return result.Permalink, fmt.Errorf("%w; also failed to record slack post: %w", postErr, err)
+		}
+		return result.Permalink, err
+	}
+
+	if postErr != nil {
+		return result.Permalink, postErr
+	}
+	if s.logger != nil {
+		s.logger.Info("posted summary to slack", "threaded", result.Threaded, "replies", result.Replies, "permalink", result.Permalink)
+	}
+	return result.Permalink, nil
+}
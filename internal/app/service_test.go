@@ -1,15 +1,23 @@
 package app
 
 import (
+	"encoding/json"
 	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"reflect"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/bashfulrobot/meetsum/config"
 	"github.com/bashfulrobot/meetsum/internal/ai"
+	"github.com/bashfulrobot/meetsum/internal/summary"
+	"github.com/bashfulrobot/meetsum/internal/webhook"
 )
 
 func TestServicePreflightMissingCommand(t *testing.T) {
@@ -142,6 +150,83 @@ OUT
 	}
 }
 
+func TestServiceRunAppliesDirectoryOverrides(t *testing.T) {
+	commandDir := t.TempDir()
+	argLogPath := filepath.Join(t.TempDir(), "argv.log")
+	writeExecutable(t, commandDir, "customer-ai", `#!/usr/bin/env bash
+cat >/dev/null
+printf "%s\n" "$@" > "${MEETSUM_ARG_LOG}"
+cat <<'OUT'
+*_SUMMARY_*
+- Completed action items
+OUT
+`)
+	t.Setenv("PATH", commandDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+	t.Setenv("MEETSUM_ARG_LOG", argLogPath)
+
+	meetingDir := createMeetingDir(t, "2026-02-04", "transcript.txt", "transcript content")
+	customerDir := filepath.Dir(meetingDir)
+	overridePath := filepath.Join(customerDir, config.DirConfigFile)
+	if err := os.WriteFile(overridePath, []byte("ai:\n  command: customer-ai\n  args: [\"--acme\"]\n"), 0644); err != nil {
+		t.Fatalf("failed to write override: %v", err)
+	}
+
+	cfg := newTestConfig(t, "missing-ai-command")
+	cfg.Paths.FileBrowserRootDir = filepath.Dir(customerDir)
+	service := NewService(cfg, nil)
+
+	if command, err := service.PreflightDir(meetingDir); err != nil || command != "customer-ai" {
+		t.Fatalf("expected the customer's command to pass preflight, got %q, %v", command, err)
+	}
+	session, err := service.Prepare(RunRequest{UserName: "Tester", MeetingDir: meetingDir})
+	if err != nil {
+		t.Fatalf("prepare failed: %v", err)
+	}
+	if files := session.Preparation().ConfigFiles; !reflect.DeepEqual(files, []string{overridePath}) {
+		t.Fatalf("expected the override file, got %v", files)
+	}
+	if _, err := session.Run(); err != nil {
+		t.Fatalf("run failed: %v", err)
+	}
+
+	if args := readArgTokens(t, argLogPath); !reflect.DeepEqual(args, []string{"--acme"}) {
+		t.Fatalf("expected the customer's args, got %v", args)
+	}
+	if cfg.AI.Command != "missing-ai-command" {
+		t.Fatalf("service config was modified: %q", cfg.AI.Command)
+	}
+}
+
+func TestServicePrepareAppliesFlagsAfterDirectoryOverrides(t *testing.T) {
+	meetingDir := createMeetingDir(t, "2026-02-05", "transcript.txt", "transcript content")
+	customerDir := filepath.Dir(meetingDir)
+	override := "features:\n  trace_mode: true\nemail:\n  enabled: true\n"
+	if err := os.WriteFile(filepath.Join(customerDir, config.DirConfigFile), []byte(override), 0644); err != nil {
+		t.Fatalf("failed to write override: %v", err)
+	}
+
+	cfg := newTestConfig(t, "fake-ai")
+	cfg.Paths.FileBrowserRootDir = filepath.Dir(customerDir)
+	service := NewService(cfg, nil)
+
+	off := false
+	session, err := service.Prepare(RunRequest{UserName: "Tester", MeetingDir: meetingDir, TraceMode: &off, WriteEmail: &off})
+	if err != nil {
+		t.Fatalf("prepare failed: %v", err)
+	}
+	if session.Config().Features.TraceMode || session.Config().Email.Enabled {
+		t.Fatalf("expected the flags to win over the override file: %+v %+v", session.Config().Features, session.Config().Email)
+	}
+
+	session, err = service.Prepare(RunRequest{UserName: "Tester", MeetingDir: meetingDir})
+	if err != nil {
+		t.Fatalf("prepare failed: %v", err)
+	}
+	if !session.Config().Features.TraceMode || !session.Config().Email.Enabled {
+		t.Fatal("expected the override file to apply without flags")
+	}
+}
+
 func TestServiceRunSupportsLegacyInlineCommandArgs(t *testing.T) {
 	commandDir := t.TempDir()
 	argLogPath := filepath.Join(t.TempDir(), "argv.log")
@@ -228,6 +313,9 @@ exit 1
 	if !strings.Contains(err.Error(), "failed to generate summary") {
 		t.Fatalf("expected generate-summary failure, got: %v", err)
 	}
+	if !errors.Is(err, ErrGeneration) || errors.Is(err, ErrInvalidSummary) {
+		t.Fatalf("expected error to be marked as a generation failure, got: %v", err)
+	}
 
 	summaryMatches, err := filepath.Glob(filepath.Join(meetingDir, "*-cadence-call-summary.md"))
 	if err != nil {
@@ -262,6 +350,9 @@ echo "Loaded cached credentials."
 	if !strings.Contains(err.Error(), "raw provider output saved to") {
 		t.Fatalf("expected diagnostic guidance in error, got: %v", err)
 	}
+	if !errors.Is(err, ErrInvalidSummary) || errors.Is(err, ErrGeneration) {
+		t.Fatalf("expected error to be marked as a validation failure, got: %v", err)
+	}
 
 	diagnosticPath := filepath.Join(meetingDir, "summary-raw-output.txt")
 	diagnosticContent, err := os.ReadFile(diagnosticPath)
@@ -404,6 +495,60 @@ OUT
 	}
 }
 
+func TestServiceRunFixesFormatting(t *testing.T) {
+	commandDir := t.TempDir()
+	writeExecutable(t, commandDir, "fake-ai-messy", `#!/usr/bin/env bash
+cat >/dev/null
+cat <<'OUT'
+*_2026-02-04 ACME CADENCE CALL SUMMARY_*
+
+*Highlights*
+• **Acme** renewed.
+
+*ACTION ITEMS*
+
+- Tester: Complete the analysis.
+
+*MEETING RECORDING*
+
+- [Meeting Recording](PLACEHOLDER_URL)
+OUT
+`)
+	t.Setenv("PATH", commandDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	for _, fix := range []bool{true, false} {
+		cfg := newTestConfig(t, "fake-ai-messy")
+		cfg.Features.LintFix = fix
+		meetingDir := createMeetingDir(t, "2026-02-04", "transcript.txt", "transcript content")
+
+		session, err := NewService(cfg, nil).Prepare(RunRequest{UserName: "Tester", MeetingDir: meetingDir})
+		if err != nil {
+			t.Fatalf("prepare failed: %v", err)
+		}
+		result, err := session.Run()
+		if err != nil {
+			t.Fatalf("run failed: %v", err)
+		}
+		content, err := os.ReadFile(result.OutputPath)
+		if err != nil {
+			t.Fatalf("failed to read summary: %v", err)
+		}
+
+		fixed := strings.Contains(string(content), "*HIGHLIGHTS*\n\n- *Acme* renewed.")
+		if fixed != fix {
+			t.Errorf("lint_fix=%v: unexpected summary:\n%s", fix, content)
+		}
+		for _, issue := range result.LintIssues {
+			if issue.Rule == summary.RuleTrailingNewline || (fix && issue.Fixable) {
+				t.Errorf("lint_fix=%v: unexpected issue %v", fix, issue)
+			}
+		}
+		if !fix && len(result.LintIssues) == 0 {
+			t.Error("expected the formatting issues to be reported")
+		}
+	}
+}
+
 func TestServiceValidationFailureNoSlackFile(t *testing.T) {
 	commandDir := t.TempDir()
 	writeExecutable(t, commandDir, "fake-ai-invalid-slack", `#!/usr/bin/env bash
@@ -447,3 +592,594 @@ func readArgTokens(t *testing.T, path string) []string {
 
 	return strings.Split(trimmed, "\n")
 }
+
+func TestServiceRunPostsToSlack(t *testing.T) {
+	commandDir := t.TempDir()
+	writeExecutable(t, commandDir, "fake-ai-slack-post", `#!/usr/bin/env bash
+cat >/dev/null
+cat <<'OUT'
+*_2026-02-04 ACME CADENCE CALL SUMMARY_*
+
+*HIGHLIGHTS*
+
+- Key insight from the meeting.
+
+*ACTION ITEMS*
+
+- Tester: Complete the analysis.
+OUT
+`)
+	t.Setenv("PATH", commandDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	var mu sync.Mutex
+	var channels []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/chat.postMessage", func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&payload)
+		mu.Lock()
+		channels = append(channels, payload["channel"].(string))
+		mu.Unlock()
+		_ = json.NewEncoder(w).Encode(map[string]any{"ok": true, "channel": payload["channel"], "ts": "1.2"})
+	})
+	mux.HandleFunc("/chat.getPermalink", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{"ok": true, "permalink": "https://slack.example/p12"})
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	cfg := newTestConfig(t, "fake-ai-slack-post")
+	cfg.Slack.Enabled = true
+	cfg.Slack.APIURL = server.URL
+	cfg.Slack.BotToken = "xoxb-test"
+	cfg.Slack.Channel = "CDEFAULT"
+	cfg.Slack.Customers = map[string]config.SlackTarget{"acme": {Channel: "CACME"}}
+	service := NewService(cfg, nil)
+	meetingDir := createMeetingDir(t, "2026-02-04", "transcript.txt", "transcript content")
+
+	session, err := service.Prepare(RunRequest{UserName: "Tester", MeetingDir: meetingDir})
+	if err != nil {
+		t.Fatalf("prepare failed: %v", err)
+	}
+
+	result, err := session.Run()
+	if err != nil {
+		t.Fatalf("run failed: %v", err)
+	}
+	if result.SlackPostWarning != "" {
+		t.Fatalf("unexpected slack post warning: %s", result.SlackPostWarning)
+	}
+	if result.SlackPermalink != "https://slack.example/p12" {
+		t.Fatalf("expected permalink from stand-in, got %q", result.SlackPermalink)
+	}
+	if len(channels) < 2 || channels[0] != "CACME" {
+		t.Fatalf("expected parent and reply posted to customer channel, got %v", channels)
+	}
+
+	var record SlackPostRecord
+	if err := summary.ReadStateFile(meetingDir, slackPostRecordFile, &record); err != nil {
+		t.Fatalf("expected slack post record: %v", err)
+	}
+	if record.Permalink != result.SlackPermalink || !record.Threaded {
+		t.Fatalf("unexpected slack post record: %+v", record)
+	}
+}
+
+func TestServiceRunSlackPostFailureIsWarning(t *testing.T) {
+	commandDir := t.TempDir()
+	writeExecutable(t, commandDir, "fake-ai-slack-fail", `#!/usr/bin/env bash
+cat >/dev/null
+cat <<'OUT'
+*_SUMMARY_*
+- Completed action items
+OUT
+`)
+	t.Setenv("PATH", commandDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	cfg := newTestConfig(t, "fake-ai-slack-fail")
+	cfg.Slack.Enabled = true
+	cfg.Slack.WebhookURL = server.URL
+	service := NewService(cfg, nil)
+	meetingDir := createMeetingDir(t, "2026-02-04", "transcript.txt", "transcript content")
+
+	session, err := service.Prepare(RunRequest{UserName: "Tester", MeetingDir: meetingDir})
+	if err != nil {
+		t.Fatalf("prepare failed: %v", err)
+	}
+
+	result, err := session.Run()
+	if err != nil {
+		t.Fatalf("slack failure must not fail the run: %v", err)
+	}
+	if !strings.Contains(result.SlackPostWarning, "HTTP 503") {
+		t.Fatalf("expected slack post warning, got %q", result.SlackPostWarning)
+	}
+	if _, err := os.Stat(result.OutputPath); err != nil {
+		t.Fatalf("expected summary to be saved: %v", err)
+	}
+}
+
+func TestServiceRunWritesKnowledgeBaseNote(t *testing.T) {
+	commandDir := t.TempDir()
+	writeExecutable(t, commandDir, "fake-ai-note", `#!/usr/bin/env bash
+cat >/dev/null
+cat <<'OUT'
+*_2026-02-04 ACME CADENCE CALL SUMMARY_*
+
+*ACTION ITEMS*
+
+- Jane Smith: Send the proposal by Friday.
+- Tester: Book the follow-up.
+OUT
+`)
+	t.Setenv("PATH", commandDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	vault := t.TempDir()
+	cfg := newTestConfig(t, "fake-ai-note")
+	cfg.Notes.Enabled = true
+	cfg.Notes.VaultDir = vault
+	cfg.Notes.FilenameTemplate = "{date} {customer}.md"
+	cfg.Notes.CustomerPage = "{customer}"
+	service := NewService(cfg, nil)
+	meetingDir := createMeetingDir(t, "2026-02-04", "transcript.txt", "transcript content")
+
+	session, err := service.Prepare(RunRequest{UserName: "Tester", MeetingDir: meetingDir})
+	if err != nil {
+		t.Fatalf("prepare failed: %v", err)
+	}
+
+	result, err := session.Run()
+	if err != nil {
+		t.Fatalf("run failed: %v", err)
+	}
+	if result.NoteWarning != "" {
+		t.Fatalf("unexpected note warning: %s", result.NoteWarning)
+	}
+	if result.NoteOutputPath != filepath.Join(vault, "2026-02-04 Acme.md") {
+		t.Fatalf("unexpected note path: %s", result.NoteOutputPath)
+	}
+	if _, err := os.Stat(result.OutputPath); err != nil {
+		t.Fatalf("expected meeting directory summary to still be written: %v", err)
+	}
+
+	note, err := os.ReadFile(result.NoteOutputPath)
+	if err != nil {
+		t.Fatalf("failed to read note: %v", err)
+	}
+	for _, expected := range []string{
+		`provider: "fake-ai-note"`,
+		`source_transcript: "2026-02-04-transcript.txt"`,
+		`  - "Jane Smith"`,
+		"action_items: 2",
+		"Customer: [[Acme]]",
+	} {
+		if !strings.Contains(string(note), expected) {
+			t.Errorf("note missing %q:\n%s", expected, note)
+		}
+	}
+}
+
+func TestServiceRunWritesFollowUpEmailDraft(t *testing.T) {
+	commandDir := t.TempDir()
+	writeExecutable(t, commandDir, "fake-ai-email", `#!/usr/bin/env bash
+prompt="$(cat)"
+if grep -q "customer-facing follow-up email" <<<"$prompt"; then
+cat <<'OUT'
+Hi Jane,
+
+Thanks for the time today.
+
+Jane Smith
+- Send the proposal by Friday.
+
+Tester
+OUT
+else
+cat <<'OUT'
+*_2026-02-04 ACME CADENCE CALL SUMMARY_*
+
+*ACTION ITEMS*
+
+- Jane Smith: Send the proposal by Friday.
+OUT
+fi
+`)
+	t.Setenv("PATH", commandDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	cfg := newTestConfig(t, "fake-ai-email")
+	cfg.Email.Enabled = true
+	cfg.Email.To = []string{"jane@acme.example"}
+	cfg.Email.SubjectTemplate = "{customer} follow-up {date}"
+	service := NewService(cfg, nil)
+	meetingDir := createMeetingDir(t, "2026-02-04", "transcript.txt", "transcript content")
+
+	session, err := service.Prepare(RunRequest{UserName: "Tester", MeetingDir: meetingDir})
+	if err != nil {
+		t.Fatalf("prepare failed: %v", err)
+	}
+
+	result, err := session.Run()
+	if err != nil {
+		t.Fatalf("run failed: %v", err)
+	}
+	if result.EmailWarning != "" {
+		t.Fatalf("unexpected email warning: %s", result.EmailWarning)
+	}
+	if filepath.Base(result.EmailOutputPath) != "2026-02-04-Acme-cadence-call-summary-email.eml" {
+		t.Fatalf("unexpected email path: %s", result.EmailOutputPath)
+	}
+
+	draft, err := os.ReadFile(result.EmailOutputPath)
+	if err != nil {
+		t.Fatalf("failed to read draft: %v", err)
+	}
+	if !strings.Contains(string(draft), "Subject: Acme follow-up 2026-02-04\r\n") {
+		t.Errorf("expected subject derived from customer and date:\n%s", draft)
+	}
+	if !strings.Contains(string(draft), "Thanks for the time today.") {
+		t.Errorf("expected generated email body:\n%s", draft)
+	}
+
+	summaryContent, _ := os.ReadFile(result.OutputPath)
+	if strings.Contains(string(summaryContent), "Thanks for the time today.") {
+		t.Error("email content leaked into the main summary")
+	}
+}
+
+func TestServiceRunFillsRecordingURL(t *testing.T) {
+	commandDir := t.TempDir()
+	writeExecutable(t, commandDir, "fake-ai-recording", `#!/usr/bin/env bash
+cat >/dev/null
+cat <<'OUT'
+*_2026-02-04 ACME CADENCE CALL SUMMARY_*
+
+*HIGHLIGHTS*
+
+- Key insight from the meeting.
+
+*ACTION ITEMS*
+
+- Tester: Complete the analysis.
+
+*MEETING RECORDING*
+
+- [Meeting Recording](PLACEHOLDER_URL)
+OUT
+`)
+	t.Setenv("PATH", commandDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	t.Run("metadata file", func(t *testing.T) {
+		cfg := newTestConfig(t, "fake-ai-recording")
+		meetingDir := createMeetingDir(t, "2026-02-04", "transcript.txt", "transcript content")
+		metadata := "recording_url: https://zoom.example.com/rec/abc\n"
+		if err := os.WriteFile(filepath.Join(meetingDir, "meeting.yaml"), []byte(metadata), 0644); err != nil {
+			t.Fatalf("failed to write metadata: %v", err)
+		}
+
+		session, err := NewService(cfg, nil).Prepare(RunRequest{UserName: "Tester", MeetingDir: meetingDir})
+		if err != nil {
+			t.Fatalf("prepare failed: %v", err)
+		}
+		result, err := session.Run()
+		if err != nil {
+			t.Fatalf("run failed: %v", err)
+		}
+
+		if result.RecordingURL != "https://zoom.example.com/rec/abc" || result.RecordingWarning != "" {
+			t.Fatalf("unexpected recording result: %q / %q", result.RecordingURL, result.RecordingWarning)
+		}
+		for _, path := range []string{result.OutputPath, result.SlackOutputPath} {
+			content, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatalf("failed to read %s: %v", path, err)
+			}
+			if strings.Contains(string(content), "PLACEHOLDER_URL") || !strings.Contains(string(content), "(https://zoom.example.com/rec/abc)") {
+				t.Errorf("expected recording link in %s:\n%s", filepath.Base(path), content)
+			}
+		}
+	})
+
+	t.Run("flag overrides metadata", func(t *testing.T) {
+		cfg := newTestConfig(t, "fake-ai-recording")
+		meetingDir := createMeetingDir(t, "2026-02-04", "transcript.txt", "transcript content")
+		if err := os.WriteFile(filepath.Join(meetingDir, "meeting.yaml"), []byte("recording_url: https://old.example.com\n"), 0644); err != nil {
+			t.Fatalf("failed to write metadata: %v", err)
+		}
+
+		session, err := NewService(cfg, nil).Prepare(RunRequest{
+			UserName:     "Tester",
+			MeetingDir:   meetingDir,
+			RecordingURL: "https://new.example.com/rec",
+		})
+		if err != nil {
+			t.Fatalf("prepare failed: %v", err)
+		}
+		result, err := session.Run()
+		if err != nil {
+			t.Fatalf("run failed: %v", err)
+		}
+		if result.RecordingURL != "https://new.example.com/rec" {
+			t.Fatalf("expected flag URL, got %q", result.RecordingURL)
+		}
+	})
+
+	t.Run("invalid flag is rejected", func(t *testing.T) {
+		cfg := newTestConfig(t, "fake-ai-recording")
+		meetingDir := createMeetingDir(t, "2026-02-04", "transcript.txt", "transcript content")
+		if _, err := NewService(cfg, nil).Prepare(RunRequest{UserName: "Tester", MeetingDir: meetingDir, RecordingURL: "not a url"}); err == nil {
+			t.Fatal("expected invalid recording URL error")
+		}
+	})
+
+	t.Run("warning when no link is found", func(t *testing.T) {
+		cfg := newTestConfig(t, "fake-ai-recording")
+		meetingDir := createMeetingDir(t, "2026-02-04", "transcript.txt", "transcript content")
+
+		session, err := NewService(cfg, nil).Prepare(RunRequest{UserName: "Tester", MeetingDir: meetingDir})
+		if err != nil {
+			t.Fatalf("prepare failed: %v", err)
+		}
+		result, err := session.Run()
+		if err != nil {
+			t.Fatalf("run failed: %v", err)
+		}
+		if result.RecordingURL != "" || !strings.Contains(result.RecordingWarning, "placeholder") {
+			t.Fatalf("expected placeholder warning, got %q / %q", result.RecordingURL, result.RecordingWarning)
+		}
+	})
+}
+
+func TestServiceRunGitAutoCommit(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	t.Setenv("GIT_AUTHOR_NAME", "Tester")
+	t.Setenv("GIT_AUTHOR_EMAIL", "tester@example.com")
+	t.Setenv("GIT_COMMITTER_NAME", "Tester")
+	t.Setenv("GIT_COMMITTER_EMAIL", "tester@example.com")
+
+	commandDir := t.TempDir()
+	writeExecutable(t, commandDir, "fake-ai-git", `#!/usr/bin/env bash
+cat >/dev/null
+cat <<'OUT'
+*_2026-02-04 ACME CADENCE CALL SUMMARY_*
+
+*HIGHLIGHTS*
+
+- Key insight from the meeting.
+
+*ACTION ITEMS*
+
+- Tester: Complete the analysis.
+
+*MEETING RECORDING*
+
+- [Meeting Recording](PLACEHOLDER_URL)
+OUT
+`)
+	t.Setenv("PATH", commandDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	t.Run("commits outputs in repository", func(t *testing.T) {
+		cfg := newTestConfig(t, "fake-ai-git")
+		cfg.Git.AutoCommit = true
+		cfg.Git.MessageTemplate = "Add {customer} meeting summary for {date} ({provider})"
+
+		meetingDir := createMeetingDir(t, "2026-02-04", "transcript.txt", "transcript content")
+		repoRoot := filepath.Dir(filepath.Dir(filepath.Dir(meetingDir)))
+		if out, err := exec.Command("git", "-C", repoRoot, "init", "--quiet").CombinedOutput(); err != nil {
+			t.Fatalf("git init failed: %v: %s", err, out)
+		}
+
+		session, err := NewService(cfg, nil).Prepare(RunRequest{UserName: "Tester", MeetingDir: meetingDir})
+		if err != nil {
+			t.Fatalf("prepare failed: %v", err)
+		}
+		result, err := session.Run()
+		if err != nil {
+			t.Fatalf("run failed: %v", err)
+		}
+		if result.GitWarning != "" || result.GitCommit == "" {
+			t.Fatalf("expected commit without warning, got %q / %q", result.GitCommit, result.GitWarning)
+		}
+
+		out, err := exec.Command("git", "-C", repoRoot, "show", "--name-only", "--format=%s", "HEAD").CombinedOutput()
+		if err != nil {
+			t.Fatalf("git show failed: %v: %s", err, out)
+		}
+		show := string(out)
+		for _, expected := range []string{
+			"Add Acme meeting summary for 2026-02-04 (fake-ai-git)",
+			"2026-02-04-Acme-cadence-call-summary.md",
+			"2026-02-04-Acme-cadence-call-summary-slack.md",
+			"2026-02-04-transcript.txt",
+		} {
+			if !strings.Contains(show, expected) {
+				t.Errorf("expected %q in commit:\n%s", expected, show)
+			}
+		}
+	})
+
+	t.Run("skips outside repository", func(t *testing.T) {
+		cfg := newTestConfig(t, "fake-ai-git")
+		cfg.Git.AutoCommit = true
+		meetingDir := createMeetingDir(t, "2026-02-04", "transcript.txt", "transcript content")
+
+		session, err := NewService(cfg, nil).Prepare(RunRequest{UserName: "Tester", MeetingDir: meetingDir})
+		if err != nil {
+			t.Fatalf("prepare failed: %v", err)
+		}
+		result, err := session.Run()
+		if err != nil {
+			t.Fatalf("run failed: %v", err)
+		}
+		if result.GitCommit != "" || result.GitWarning != "" {
+			t.Fatalf("expected clean skip, got %q / %q", result.GitCommit, result.GitWarning)
+		}
+	})
+}
+
+func TestGitCommitMessage(t *testing.T) {
+	cfg := newTestConfig(t, "fake-ai-git-message")
+	meetingDir := createMeetingDir(t, "2026-02-04", "transcript.txt", "transcript content")
+	session, err := NewService(cfg, nil).Prepare(RunRequest{UserName: "Tester", MeetingDir: meetingDir})
+	if err != nil {
+		t.Fatalf("prepare failed: %v", err)
+	}
+
+	cases := map[string]string{
+		"{customer} {type} summary by {user}": "Acme cadence-call summary by Tester",
+		"{unknown}":                           "Add Acme meeting summary for 2026-02-04 (fake-ai-git-message)",
+	}
+	for tmpl, want := range cases {
+		cfg.Git.MessageTemplate = tmpl
+		if got := session.gitCommitMessage(); got != want {
+			t.Errorf("template %q: expected %q, got %q", tmpl, want, got)
+		}
+	}
+}
+
+func TestServiceRunSendsWebhookEvents(t *testing.T) {
+	commandDir := t.TempDir()
+	writeExecutable(t, commandDir, "fake-ai-webhook", `#!/usr/bin/env bash
+cat >/dev/null
+cat <<'OUT'
+*_2026-02-04 ACME CADENCE CALL SUMMARY_*
+
+*HIGHLIGHTS*
+
+- Renewal confirmed.
+
+*ACTION ITEMS*
+
+- Tester: Send the proposal by Friday.
+
+*MEETING RECORDING*
+
+- [Meeting Recording](PLACEHOLDER_URL)
+OUT
+`)
+	writeExecutable(t, commandDir, "fake-ai-webhook-fail", `#!/usr/bin/env bash
+cat >/dev/null
+echo "boom" >&2
+exit 3
+`)
+	t.Setenv("PATH", commandDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	var mu sync.Mutex
+	var events []webhook.Event
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.Header.Get(webhook.SignatureHeader) != webhook.Sign("s3cret", body) {
+			http.Error(w, "bad signature", http.StatusUnauthorized)
+			return
+		}
+		var event webhook.Event
+		_ = json.Unmarshal(body, &event)
+		mu.Lock()
+		events = append(events, event)
+		mu.Unlock()
+	}))
+	defer ts.Close()
+
+	run := func(command string) (RunResult, error) {
+		cfg := newTestConfig(t, command)
+		cfg.Webhooks.URLs = []string{ts.URL}
+		cfg.Webhooks.Secret = "s3cret"
+		cfg.Webhooks.Attempts = 1
+		meetingDir := createMeetingDir(t, "2026-02-04", "transcript.txt", "transcript content")
+
+		session, err := NewService(cfg, nil).Prepare(RunRequest{UserName: "Tester", MeetingDir: meetingDir})
+		if err != nil {
+			t.Fatalf("prepare failed: %v", err)
+		}
+		return session.Run()
+	}
+
+	result, err := run("fake-ai-webhook")
+	if err != nil {
+		t.Fatalf("run failed: %v", err)
+	}
+	if result.WebhookWarning != "" {
+		t.Fatalf("unexpected webhook warning: %s", result.WebhookWarning)
+	}
+
+	if _, err := run("fake-ai-webhook-fail"); err == nil {
+		t.Fatal("expected run failure")
+	}
+
+	if len(events) != 2 {
+		t.Fatalf("expected 2 events, got %d", len(events))
+	}
+
+	success := events[0]
+	if success.Event != webhook.EventRunSucceeded || success.Customer != "Acme" || success.Date != "2026-02-04" || success.Provider != "fake-ai-webhook" {
+		t.Errorf("unexpected success event: %+v", success)
+	}
+	if success.Outputs.Summary != result.OutputPath || success.Outputs.SlackSummary != result.SlackOutputPath {
+		t.Errorf("unexpected outputs: %+v", success.Outputs)
+	}
+	if len(success.Highlights) != 1 || success.Highlights[0] != "Renewal confirmed." {
+		t.Errorf("unexpected highlights: %v", success.Highlights)
+	}
+	if len(success.ActionItems) != 1 || success.ActionItems[0].Due != "2026-02-06" {
+		t.Errorf("unexpected action items: %+v", success.ActionItems)
+	}
+
+	failure := events[1]
+	if failure.Event != webhook.EventRunFailed || failure.Error == "" || failure.Outputs.Summary != "" {
+		t.Errorf("unexpected failure event: %+v", failure)
+	}
+}
+
+func TestServiceRunWebhookFailureIsWarning(t *testing.T) {
+	commandDir := t.TempDir()
+	writeExecutable(t, commandDir, "fake-ai-webhook-down", `#!/usr/bin/env bash
+cat >/dev/null
+cat <<'OUT'
+*_2026-02-04 ACME CADENCE CALL SUMMARY_*
+
+*HIGHLIGHTS*
+
+- Renewal confirmed.
+
+*ACTION ITEMS*
+
+- Tester: Send the proposal.
+
+*MEETING RECORDING*
+
+- [Meeting Recording](PLACEHOLDER_URL)
+OUT
+`)
+	t.Setenv("PATH", commandDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "forbidden", http.StatusForbidden)
+	}))
+	defer ts.Close()
+
+	cfg := newTestConfig(t, "fake-ai-webhook-down")
+	cfg.Webhooks.URLs = []string{ts.URL}
+	cfg.Webhooks.Attempts = 3
+	meetingDir := createMeetingDir(t, "2026-02-04", "transcript.txt", "transcript content")
+
+	session, err := NewService(cfg, nil).Prepare(RunRequest{UserName: "Tester", MeetingDir: meetingDir})
+	if err != nil {
+		t.Fatalf("prepare failed: %v", err)
+	}
+	result, err := session.Run()
+	if err != nil {
+		t.Fatalf("webhook failure must not fail the run: %v", err)
+	}
+	if !strings.Contains(result.WebhookWarning, "HTTP 403") {
+		t.Fatalf("expected webhook warning, got %q", result.WebhookWarning)
+	}
+	if _, err := os.Stat(result.OutputPath); err != nil {
+		t.Fatalf("summary should still be saved: %v", err)
+	}
+}
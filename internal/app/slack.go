@@ -0,0 +1,46 @@
+package app
+
+import (
+	"strings"
+
+	"github.com/bashfulrobot/meetsum/internal/summary"
+)
+
+// SlackRebuildResult captures output from rebuilding a Slack mini summary.
+type SlackRebuildResult struct {
+	SummaryPath     string
+	SlackOutputPath string
+	Content         string
+}
+
+// RebuildSlackSummary re-derives the Slack mini summary from the saved main
+// summary, for example after it was edited by hand. The AI is not called.
+func (s *Service) RebuildSlackSummary(meetingDir string) (SlackRebuildResult, error) {
+	meetingDir = strings.TrimSpace(meetingDir)
+	dirConfig, err := s.dirConfig(meetingDir)
+	if err != nil {
+		return SlackRebuildResult{}, err
+	}
+	processor := summary.NewProcessor(dirConfig.Config, s.logger)
+	processor.SetUserName(dirConfig.Config.User.Name)
+	processor.SetMeetingDir(meetingDir)
+
+	content, summaryPath, err := processor.LoadSavedSummary()
+	if err != nil {
+		return SlackRebuildResult{}, err
+	}
+
+	slackContent := processor.BuildSlackSummary(content)
+	if err := archiveOutputs(processor, meetingDir, "slack", s.logger); err != nil {
+		return SlackRebuildResult{}, err
+	}
+	slackPath, err := processor.SaveSlackSummary(slackContent)
+	if err != nil {
+		return SlackRebuildResult{}, err
+	}
+
+	if s.logger != nil {
+		s.logger.Info("rebuilt slack summary", "summary", summaryPath, "slack", slackPath)
+	}
+	return SlackRebuildResult{SummaryPath: summaryPath, SlackOutputPath: slackPath, Content: slackContent}, nil
+}
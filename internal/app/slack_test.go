@@ -0,0 +1,64 @@
+package app
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/bashfulrobot/meetsum/internal/history"
+)
+
+func TestServiceRebuildSlackSummary(t *testing.T) {
+	cfg := newTestConfig(t, "fake-ai-unused")
+	meetingDir := createMeetingDir(t, "2026-02-04", "transcript.txt", "transcript content")
+	edited := `*_2026-02-04 ACME CADENCE CALL SUMMARY_*
+
+*HIGHLIGHTS*
+
+- Acme renewed for two years.
+
+*ACTION ITEMS*
+
+- Tester: Send the hand-corrected pricing sheet`
+	summaryPath := filepath.Join(meetingDir, "2026-02-04-Acme-cadence-call-summary.md")
+	if err := os.WriteFile(summaryPath, []byte(edited), 0644); err != nil {
+		t.Fatalf("failed to write summary: %v", err)
+	}
+	stalePath := filepath.Join(meetingDir, "2026-02-04-Acme-cadence-call-summary-slack.md")
+	if err := os.WriteFile(stalePath, []byte("stale"), 0644); err != nil {
+		t.Fatalf("failed to write Slack summary: %v", err)
+	}
+
+	result, err := NewService(cfg, nil).RebuildSlackSummary(meetingDir)
+	if err != nil {
+		t.Fatalf("rebuild failed: %v", err)
+	}
+	if result.SlackOutputPath != stalePath || result.SummaryPath != summaryPath {
+		t.Errorf("unexpected paths: %+v", result)
+	}
+
+	slack, err := os.ReadFile(stalePath)
+	if err != nil {
+		t.Fatalf("failed to read Slack summary: %v", err)
+	}
+	for _, want := range []string{"two years", "hand-corrected pricing sheet"} {
+		if !strings.Contains(string(slack), want) {
+			t.Errorf("Slack summary missing %q:\n%s", want, slack)
+		}
+	}
+
+	versions, err := history.List(meetingDir)
+	if err != nil || len(versions) != 1 || versions[0].Reason != "slack" {
+		t.Fatalf("expected the stale Slack summary to be archived, got %+v (%v)", versions, err)
+	}
+}
+
+func TestServiceRebuildSlackSummaryWithoutSummary(t *testing.T) {
+	cfg := newTestConfig(t, "fake-ai-unused")
+	meetingDir := createMeetingDir(t, "2026-02-04", "transcript.txt", "transcript content")
+
+	if _, err := NewService(cfg, nil).RebuildSlackSummary(meetingDir); err == nil || !strings.Contains(err.Error(), "no summary found") {
+		t.Errorf("expected a missing summary error, got %v", err)
+	}
+}
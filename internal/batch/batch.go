@@ -0,0 +1,195 @@
+package batch
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/bashfulrobot/meetsum/internal/app"
+	"github.com/bashfulrobot/meetsum/internal/meetings"
+)
+
+// State is the progress of one meeting in a batch.
+type State int
+
+const (
+	StateQueued State = iota
+	StateWaiting
+	StateRunning
+	StateSucceeded
+	StateFailed
+	StateCanceled
+)
+
+// String returns a short label for the state.
+func (s State) String() string {
+	switch s {
+	case StateQueued:
+		return "queued"
+	case StateWaiting:
+		return "rate limited"
+	case StateRunning:
+		return "running"
+	case StateSucceeded:
+		return "done"
+	case StateFailed:
+		return "failed"
+	case StateCanceled:
+		return "canceled"
+	default:
+		return "unknown"
+	}
+}
+
+// Done reports whether the state is final.
+func (s State) Done() bool {
+	return s == StateSucceeded || s == StateFailed || s == StateCanceled
+}
+
+// RunFunc summarizes one meeting.
+type RunFunc func(meeting meetings.Meeting) (app.RunResult, error)
+
+// Update reports a state change for the meeting at Index.
+type Update struct {
+	Index int
+	State State
+}
+
+// Outcome is the final result for one meeting.
+type Outcome struct {
+	Meeting  meetings.Meeting
+	State    State
+	Result   app.RunResult
+	Err      error
+	Duration time.Duration
+}
+
+// Options controls batch concurrency.
+type Options struct {
+	// Workers is the number of meetings summarized at once (minimum 1).
+	Workers int
+	// PerMinute caps runs started per minute. Zero means unlimited.
+	PerMinute int
+}
+
+// Run summarizes every meeting with a pool of workers and returns one
+// outcome per meeting in input order. onUpdate, when set, is called from
+// worker goroutines on every state change. Meetings not started when ctx is
+// canceled are marked canceled.
+func Run(ctx context.Context, items []meetings.Meeting, options Options, run RunFunc, onUpdate func(Update)) []Outcome {
+	workers := options.Workers
+	if workers < 1 {
+		workers = 1
+	}
+	if workers > len(items) {
+		workers = len(items)
+	}
+
+	notify := func(index int, state State) {
+		if onUpdate != nil {
+			onUpdate(Update{Index: index, State: state})
+		}
+	}
+
+	outcomes := make([]Outcome, len(items))
+	for i, meeting := range items {
+		outcomes[i] = Outcome{Meeting: meeting, State: StateQueued}
+	}
+
+	limiter := newLimiter(options.PerMinute)
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range indexes {
+				if limiter.interval > 0 {
+					notify(index, StateWaiting)
+				}
+				if err := limiter.wait(ctx); err != nil {
+					outcomes[index].State = StateCanceled
+					outcomes[index].Err = err
+					notify(index, StateCanceled)
+					continue
+				}
+
+				notify(index, StateRunning)
+				started := time.Now()
+				result, err := run(items[index])
+				outcomes[index].Duration = time.Since(started)
+				outcomes[index].Result = result
+				outcomes[index].Err = err
+				outcomes[index].State = StateSucceeded
+				if err != nil {
+					outcomes[index].State = StateFailed
+				}
+				notify(index, outcomes[index].State)
+			}
+		}()
+	}
+
+	for i := range items {
+		if ctx.Err() != nil {
+			outcomes[i].State = StateCanceled
+			outcomes[i].Err = ctx.Err()
+			notify(i, StateCanceled)
+			continue
+		}
+		select {
+		case indexes <- i:
+		case <-ctx.Done():
+			outcomes[i].State = StateCanceled
+			outcomes[i].Err = ctx.Err()
+			notify(i, StateCanceled)
+		}
+	}
+	close(indexes)
+	wg.Wait()
+
+	return outcomes
+}
+
+// limiter spaces run starts evenly so no more than perMinute begin in any
+// minute. A zero rate never waits.
+type limiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+func newLimiter(perMinute int) *limiter {
+	if perMinute <= 0 {
+		return &limiter{}
+	}
+	return &limiter{interval: time.Minute / time.Duration(perMinute)}
+}
+
+// wait blocks until the caller may start a run or ctx is canceled.
+func (l *limiter) wait(ctx context.Context) error {
+	if l.interval == 0 {
+		return ctx.Err()
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	start := l.next
+	if start.Before(now) {
+		start = now
+	}
+	l.next = start.Add(l.interval)
+	l.mu.Unlock()
+
+	delay := time.Until(start)
+	if delay <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,110 @@
+package batch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/bashfulrobot/meetsum/internal/app"
+	"github.com/bashfulrobot/meetsum/internal/meetings"
+)
+
+func testMeetings(n int) []meetings.Meeting {
+	items := make([]meetings.Meeting, n)
+	for i := range items {
+		items[i] = meetings.Meeting{Dir: fmt.Sprintf("/customers/acme/2025-10-%02d", i+1)}
+	}
+	return items
+}
+
+func TestRunUsesWorkerPool(t *testing.T) {
+	var running, peak atomic.Int32
+	run := func(meeting meetings.Meeting) (app.RunResult, error) {
+		current := running.Add(1)
+		for {
+			previous := peak.Load()
+			if current <= previous || peak.CompareAndSwap(previous, current) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		running.Add(-1)
+		if meeting.Dir == "/customers/acme/2025-10-03" {
+			return app.RunResult{}, errors.New("provider failed")
+		}
+		return app.RunResult{OutputPath: meeting.Dir + "/summary.md"}, nil
+	}
+
+	var mu sync.Mutex
+	updates := map[int][]State{}
+	outcomes := Run(context.Background(), testMeetings(6), Options{Workers: 2}, run, func(u Update) {
+		mu.Lock()
+		defer mu.Unlock()
+		updates[u.Index] = append(updates[u.Index], u.State)
+	})
+
+	if got := peak.Load(); got != 2 {
+		t.Errorf("expected 2 concurrent runs, got %d", got)
+	}
+	if len(outcomes) != 6 {
+		t.Fatalf("expected 6 outcomes, got %d", len(outcomes))
+	}
+	for i, outcome := range outcomes {
+		want := StateSucceeded
+		if i == 2 {
+			want = StateFailed
+		}
+		if outcome.State != want {
+			t.Errorf("meeting %d: expected %s, got %s (%v)", i, want, outcome.State, outcome.Err)
+		}
+		if outcome.Meeting.Dir != testMeetings(6)[i].Dir {
+			t.Errorf("meeting %d: outcomes out of order: %s", i, outcome.Meeting.Dir)
+		}
+		if states := updates[i]; len(states) != 2 || states[0] != StateRunning || !states[1].Done() {
+			t.Errorf("meeting %d: unexpected updates %v", i, states)
+		}
+	}
+}
+
+func TestRunRateLimit(t *testing.T) {
+	var mu sync.Mutex
+	var starts []time.Time
+	run := func(meetings.Meeting) (app.RunResult, error) {
+		mu.Lock()
+		starts = append(starts, time.Now())
+		mu.Unlock()
+		return app.RunResult{}, nil
+	}
+
+	// 1200 per minute is one start every 50ms
+	Run(context.Background(), testMeetings(3), Options{Workers: 3, PerMinute: 1200}, run, nil)
+
+	if len(starts) != 3 {
+		t.Fatalf("expected 3 runs, got %d", len(starts))
+	}
+	if spread := starts[2].Sub(starts[0]); spread < 90*time.Millisecond {
+		t.Errorf("expected starts to be spaced by the rate limit, spread was %s", spread)
+	}
+}
+
+func TestRunCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	run := func(meetings.Meeting) (app.RunResult, error) {
+		cancel()
+		return app.RunResult{}, nil
+	}
+
+	outcomes := Run(ctx, testMeetings(4), Options{Workers: 1}, run, nil)
+	if outcomes[0].State != StateSucceeded {
+		t.Errorf("expected the running meeting to finish, got %s", outcomes[0].State)
+	}
+	for _, outcome := range outcomes[1:] {
+		if outcome.State != StateCanceled || !errors.Is(outcome.Err, context.Canceled) {
+			t.Errorf("expected queued meetings to be canceled, got %s (%v)", outcome.State, outcome.Err)
+		}
+	}
+}
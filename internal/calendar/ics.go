@@ -0,0 +1,285 @@
+package calendar
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Person is an organizer or attendee from an invite.
+type Person struct {
+	Name  string
+	Email string
+}
+
+// String renders the person as "Name <email>", falling back to whichever is set.
+func (p Person) String() string {
+	switch {
+	case p.Name != "" && p.Email != "":
+		return fmt.Sprintf("%s <%s>", p.Name, p.Email)
+	case p.Name != "":
+		return p.Name
+	default:
+		return p.Email
+	}
+}
+
+// DisplayName returns the name, or the email local part when no name is set.
+func (p Person) DisplayName() string {
+	if p.Name != "" {
+		return p.Name
+	}
+	local, _, _ := strings.Cut(p.Email, "@")
+	return local
+}
+
+// Domain returns the lowercase email domain.
+func (p Person) Domain() string {
+	_, domain, found := strings.Cut(p.Email, "@")
+	if !found {
+		return ""
+	}
+	return strings.ToLower(domain)
+}
+
+// Invite holds the fields meetsum uses from the first VEVENT of an .ics file.
+type Invite struct {
+	Title     string
+	Start     time.Time
+	AllDay    bool
+	Recurring bool
+	Organizer Person
+	Attendees []Person
+}
+
+// Date returns the meeting date as YYYY-MM-DD. Recurring series report no
+// date because DTSTART is the first occurrence, not this meeting.
+func (i Invite) Date() string {
+	if i.Start.IsZero() || i.Recurring {
+		return ""
+	}
+	return i.Start.Format("2006-01-02")
+}
+
+// personalDomains are consumer mail providers, which never identify a company.
+var personalDomains = map[string]bool{
+	"aol.com": true, "gmail.com": true, "googlemail.com": true, "hotmail.com": true,
+	"icloud.com": true, "live.com": true, "me.com": true, "msn.com": true,
+	"outlook.com": true, "proton.me": true, "protonmail.com": true, "yahoo.com": true,
+}
+
+// ExternalDomain returns the most common attendee email domain outside the
+// organizer's and the given internal domains (and their subdomains), which is
+// usually the customer's. Personal mail providers such as gmail.com are
+// skipped. Ties resolve alphabetically; "" means no attendee qualifies.
+func (i Invite) ExternalDomain(internal ...string) string {
+	internal = append([]string{i.Organizer.Domain()}, internal...)
+	counts := map[string]int{}
+	for _, attendee := range i.Attendees {
+		if domain := attendee.Domain(); domain != "" && !personalDomains[domain] && !matchesDomain(domain, internal) {
+			counts[domain]++
+		}
+	}
+
+	domains := make([]string, 0, len(counts))
+	for domain := range counts {
+		domains = append(domains, domain)
+	}
+	sort.Slice(domains, func(a, b int) bool {
+		if counts[domains[a]] != counts[domains[b]] {
+			return counts[domains[a]] > counts[domains[b]]
+		}
+		return domains[a] < domains[b]
+	})
+
+	if len(domains) == 0 {
+		return ""
+	}
+	return domains[0]
+}
+
+// matchesDomain reports whether domain is one of domains or a subdomain of one.
+func matchesDomain(domain string, domains []string) bool {
+	for _, candidate := range domains {
+		if candidate != "" && (domain == candidate || strings.HasSuffix(domain, "."+candidate)) {
+			return true
+		}
+	}
+	return false
+}
+
+// FindInvite returns the first .ics file in dir, sorted by name, or "".
+func FindInvite(dir string) string {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return ""
+	}
+	var names []string
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.EqualFold(filepath.Ext(entry.Name()), ".ics") {
+			names = append(names, entry.Name())
+		}
+	}
+	if len(names) == 0 {
+		return ""
+	}
+	sort.Strings(names)
+	return filepath.Join(dir, names[0])
+}
+
+// ParseFile parses an .ics file.
+func ParseFile(path string) (Invite, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return Invite{}, err
+	}
+	defer file.Close()
+	return Parse(file)
+}
+
+// Parse reads the first VEVENT from iCalendar data.
+func Parse(r io.Reader) (Invite, error) {
+	lines, err := unfold(r)
+	if err != nil {
+		return Invite{}, err
+	}
+
+	var invite Invite
+	inEvent, found := false, false
+	for _, line := range lines {
+		name, params, value := splitProperty(line)
+
+		switch {
+		case name == "BEGIN" && strings.EqualFold(value, "VEVENT"):
+			inEvent, found = true, true
+			continue
+		case name == "END" && strings.EqualFold(value, "VEVENT"):
+			return invite, nil
+		case !inEvent:
+			continue
+		}
+
+		switch name {
+		case "SUMMARY":
+			invite.Title = unescapeText(value)
+		case "DTSTART":
+			start, allDay, err := parseDateTime(value, params)
+			if err != nil {
+				return Invite{}, fmt.Errorf("invalid DTSTART %q: %w", value, err)
+			}
+			invite.Start, invite.AllDay = start, allDay
+		case "RRULE":
+			invite.Recurring = true
+		case "ORGANIZER":
+			invite.Organizer = parsePerson(value, params)
+		case "ATTENDEE":
+			if person := parsePerson(value, params); person.Email != "" || person.Name != "" {
+				invite.Attendees = append(invite.Attendees, person)
+			}
+		}
+	}
+
+	if !found {
+		return Invite{}, fmt.Errorf("no VEVENT found")
+	}
+	return invite, nil
+}
+
+// unfold joins RFC 5545 continuation lines (starting with space or tab).
+func unfold(r io.Reader) ([]string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines, scanner.Err()
+}
+
+// splitProperty splits "NAME;PARAM=x;PARAM2=y:value". Parameter values may be
+// quoted and contain ':' or ';'.
+func splitProperty(line string) (string, map[string]string, string) {
+	inQuotes := false
+	colon := -1
+	for i, r := range line {
+		if r == '"' {
+			inQuotes = !inQuotes
+		} else if r == ':' && !inQuotes {
+			colon = i
+			break
+		}
+	}
+	if colon < 0 {
+		return strings.ToUpper(line), nil, ""
+	}
+
+	head, value := line[:colon], line[colon+1:]
+	parts := splitParams(head)
+	params := map[string]string{}
+	for _, part := range parts[1:] {
+		key, val, _ := strings.Cut(part, "=")
+		params[strings.ToUpper(key)] = strings.Trim(val, `"`)
+	}
+	return strings.ToUpper(parts[0]), params, value
+}
+
+func splitParams(head string) []string {
+	var parts []string
+	var current strings.Builder
+	inQuotes := false
+	for _, r := range head {
+		switch {
+		case r == '"':
+			inQuotes = !inQuotes
+			current.WriteRune(r)
+		case r == ';' && !inQuotes:
+			parts = append(parts, current.String())
+			current.Reset()
+		default:
+			current.WriteRune(r)
+		}
+	}
+	return append(parts, current.String())
+}
+
+func parseDateTime(value string, params map[string]string) (time.Time, bool, error) {
+	if params["VALUE"] == "DATE" || len(value) == 8 {
+		t, err := time.ParseInLocation("20060102", value, time.Local)
+		return t, true, err
+	}
+	if strings.HasSuffix(value, "Z") {
+		t, err := time.Parse("20060102T150405Z", value)
+		return t.In(time.Local), false, err
+	}
+
+	location := time.Local
+	if tzid := params["TZID"]; tzid != "" {
+		if loaded, err := time.LoadLocation(tzid); err == nil {
+			location = loaded
+		}
+	}
+	t, err := time.ParseInLocation("20060102T150405", value, location)
+	return t, false, err
+}
+
+func parsePerson(value string, params map[string]string) Person {
+	email := value
+	if len(email) >= 7 && strings.EqualFold(email[:7], "mailto:") {
+		email = email[7:]
+	}
+	return Person{Name: unescapeText(params["CN"]), Email: strings.TrimSpace(email)}
+}
+
+func unescapeText(value string) string {
+	return strings.NewReplacer(`\n`, " ", `\N`, " ", `\,`, ",", `\;`, ";", `\\`, `\`).Replace(strings.TrimSpace(value))
+}
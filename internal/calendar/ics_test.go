@@ -0,0 +1,108 @@
+package calendar
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const sampleInvite = "BEGIN:VCALENDAR\r\n" +
+	"VERSION:2.0\r\n" +
+	"BEGIN:VTIMEZONE\r\n" +
+	"TZID:America/Vancouver\r\n" +
+	"END:VTIMEZONE\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"DTSTART;TZID=America/Vancouver:20250924T100000\r\n" +
+	"SUMMARY:Acme\\, Inc. <> Kong: Quarterly\r\n" +
+	"  Review\r\n" +
+	"ORGANIZER;CN=Dustin Krysak:mailto:dustin@konghq.com\r\n" +
+	"ATTENDEE;CN=\"Lee, Sam\";ROLE=REQ-PARTICIPANT:mailto:sam@acme.com\r\n" +
+	"ATTENDEE;CN=Pat Jones:MAILTO:pat@acme.com\r\n" +
+	"ATTENDEE:mailto:ops@partner.io\r\n" +
+	"ATTENDEE;CN=Dustin Krysak:mailto:dustin@konghq.com\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"SUMMARY:Second event\r\n" +
+	"END:VEVENT\r\n" +
+	"END:VCALENDAR\r\n"
+
+func TestParse(t *testing.T) {
+	invite, err := Parse(strings.NewReader(sampleInvite))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if invite.Title != "Acme, Inc. <> Kong: Quarterly Review" {
+		t.Errorf("unexpected title %q", invite.Title)
+	}
+	if invite.Date() != "2025-09-24" || invite.AllDay || invite.Recurring {
+		t.Errorf("unexpected start %v (all day %v, recurring %v)", invite.Start, invite.AllDay, invite.Recurring)
+	}
+	if invite.Organizer != (Person{Name: "Dustin Krysak", Email: "dustin@konghq.com"}) {
+		t.Errorf("unexpected organizer %+v", invite.Organizer)
+	}
+
+	want := []string{"Lee, Sam <sam@acme.com>", "Pat Jones <pat@acme.com>", "ops@partner.io", "Dustin Krysak <dustin@konghq.com>"}
+	if len(invite.Attendees) != len(want) {
+		t.Fatalf("expected %d attendees, got %+v", len(want), invite.Attendees)
+	}
+	for i, attendee := range invite.Attendees {
+		if attendee.String() != want[i] {
+			t.Errorf("attendee %d: expected %q, got %q", i, want[i], attendee.String())
+		}
+	}
+
+	if domain := invite.ExternalDomain(); domain != "acme.com" {
+		t.Errorf("expected external domain acme.com, got %q", domain)
+	}
+}
+
+func TestParseDates(t *testing.T) {
+	cases := []struct {
+		name   string
+		lines  string
+		expect string
+	}{
+		{"utc", "DTSTART:20251001T170000Z\r\n", "2025-10-01"},
+		{"all day", "DTSTART;VALUE=DATE:20251002\r\n", "2025-10-02"},
+		{"recurring series has no date", "DTSTART:20250101T170000Z\r\nRRULE:FREQ=WEEKLY\r\n", ""},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			invite, err := Parse(strings.NewReader("BEGIN:VEVENT\r\n" + tc.lines + "END:VEVENT\r\n"))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := invite.Date(); got != tc.expect {
+				t.Errorf("expected %q, got %q", tc.expect, got)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	if _, err := Parse(strings.NewReader("BEGIN:VCALENDAR\nEND:VCALENDAR\n")); err == nil {
+		t.Error("expected an error for a calendar without events")
+	}
+	if _, err := Parse(strings.NewReader("BEGIN:VEVENT\nDTSTART:tomorrow\nEND:VEVENT\n")); err == nil {
+		t.Error("expected an error for an invalid DTSTART")
+	}
+}
+
+func TestFindInvite(t *testing.T) {
+	dir := t.TempDir()
+	if got := FindInvite(dir); got != "" {
+		t.Errorf("expected no invite, got %q", got)
+	}
+
+	for _, name := range []string{"transcript.txt", "b.ics", "a.ICS"} {
+		if err := os.WriteFile(filepath.Join(dir, name), nil, 0644); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+	}
+	if got := FindInvite(dir); got != filepath.Join(dir, "a.ICS") {
+		t.Errorf("expected a.ICS, got %q", got)
+	}
+}
@@ -0,0 +1,105 @@
+package email
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net/mail"
+	"strings"
+	"time"
+)
+
+// Draft describes an unsent plain-text email.
+type Draft struct {
+	From    string
+	To      []string
+	Cc      []string
+	Subject string
+	Body    string
+	Date    time.Time
+}
+
+// Build renders the draft as an RFC 5322 message with a quoted-printable
+// UTF-8 body. X-Unsent marks it as a draft so mail clients open it for editing.
+func Build(d Draft) ([]byte, error) {
+	var buf bytes.Buffer
+
+	writeHeader := func(name, value string) {
+		buf.WriteString(name)
+		buf.WriteString(": ")
+		buf.WriteString(value)
+		buf.WriteString("\r\n")
+	}
+
+	if d.From != "" {
+		from, err := formatAddressList([]string{d.From})
+		if err != nil {
+			return nil, fmt.Errorf("invalid from address: %w", err)
+		}
+		writeHeader("From", from)
+	}
+	if len(d.To) > 0 {
+		to, err := formatAddressList(d.To)
+		if err != nil {
+			return nil, fmt.Errorf("invalid to address: %w", err)
+		}
+		writeHeader("To", to)
+	}
+	if len(d.Cc) > 0 {
+		cc, err := formatAddressList(d.Cc)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cc address: %w", err)
+		}
+		writeHeader("Cc", cc)
+	}
+
+	date := d.Date
+	if date.IsZero() {
+		date = time.Now()
+	}
+
+	writeHeader("Subject", mime.QEncoding.Encode("utf-8", d.Subject))
+	writeHeader("Date", date.Format(time.RFC1123Z))
+	writeHeader("Message-ID", messageID())
+	writeHeader("X-Unsent", "1")
+	writeHeader("MIME-Version", "1.0")
+	writeHeader("Content-Type", `text/plain; charset="utf-8"`)
+	writeHeader("Content-Transfer-Encoding", "quoted-printable")
+	buf.WriteString("\r\n")
+
+	body := strings.ReplaceAll(strings.TrimSpace(d.Body), "\r\n", "\n")
+	body = strings.ReplaceAll(body, "\n", "\r\n") + "\r\n"
+
+	qp := quotedprintable.NewWriter(&buf)
+	if _, err := qp.Write([]byte(body)); err != nil {
+		return nil, err
+	}
+	if err := qp.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func formatAddressList(values []string) (string, error) {
+	formatted := make([]string, 0, len(values))
+	for _, value := range values {
+		address, err := mail.ParseAddress(strings.TrimSpace(value))
+		if err != nil {
+			return "", fmt.Errorf("%q: %w", value, err)
+		}
+		formatted = append(formatted, address.String())
+	}
+	return strings.Join(formatted, ", "), nil
+}
+
+func messageID() string {
+	random := make([]byte, 12)
+	if _, err := rand.Read(random); err != nil {
+		return fmt.Sprintf("<%d@meetsum>", time.Now().UnixNano())
+	}
+	return fmt.Sprintf("<%s@meetsum>", hex.EncodeToString(random))
+}
@@ -0,0 +1,58 @@
+package email
+
+import (
+	"io"
+	"mime"
+	"net/mail"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBuildProducesParseableMessage(t *testing.T) {
+	raw, err := Build(Draft{
+		From:    "Tester <tester@example.com>",
+		To:      []string{"jane@acme.example", "John Doe <john@acme.example>"},
+		Cc:      []string{"team@example.com"},
+		Subject: "Acme follow-up — 2026-02-04",
+		Body:    "Hi Jane,\n\nThanks for the time today. Café details below.\n\nTester",
+		Date:    time.Date(2026, 2, 4, 15, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("build failed: %v", err)
+	}
+
+	if strings.Contains(strings.ReplaceAll(string(raw), "\r\n", ""), "\n") {
+		t.Error("expected CRLF line endings throughout")
+	}
+
+	msg, err := mail.ReadMessage(strings.NewReader(string(raw)))
+	if err != nil {
+		t.Fatalf("message is not RFC 5322 parseable: %v", err)
+	}
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	if err != nil || subject != "Acme follow-up — 2026-02-04" {
+		t.Errorf("unexpected subject %q (%v)", subject, err)
+	}
+	if to, _ := msg.Header.AddressList("To"); len(to) != 2 {
+		t.Errorf("expected two recipients, got %v", to)
+	}
+	if msg.Header.Get("X-Unsent") != "1" {
+		t.Error("expected X-Unsent draft marker")
+	}
+	if msg.Header.Get("Date") != "Wed, 04 Feb 2026 15:00:00 +0000" {
+		t.Errorf("unexpected date header %q", msg.Header.Get("Date"))
+	}
+
+	body, _ := io.ReadAll(msg.Body)
+	if !strings.Contains(string(body), "Caf=C3=A9") {
+		t.Errorf("expected quoted-printable body, got %q", body)
+	}
+}
+
+func TestBuildRejectsInvalidAddress(t *testing.T) {
+	if _, err := Build(Draft{To: []string{"not an address"}, Subject: "x", Body: "y"}); err == nil {
+		t.Error("expected invalid address error")
+	}
+}
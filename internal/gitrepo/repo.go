@@ -0,0 +1,129 @@
+package gitrepo
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// ErrNotRepository is returned when a directory is not inside a git work tree.
+var ErrNotRepository = errors.New("not inside a git repository")
+
+// ErrNothingToCommit is returned when the given paths have no changes.
+var ErrNothingToCommit = errors.New("nothing to commit")
+
+// Repo is a git work tree driven through the git CLI.
+type Repo struct {
+	root string
+}
+
+// Open finds the work tree containing dir. Returns ErrNotRepository when dir is
+// not in a repository or git is not installed.
+func Open(ctx context.Context, dir string) (*Repo, error) {
+	if _, err := exec.LookPath("git"); err != nil {
+		return nil, ErrNotRepository
+	}
+	out, err := run(ctx, dir, "rev-parse", "--show-toplevel")
+	if err != nil {
+		return nil, ErrNotRepository
+	}
+	return &Repo{root: strings.TrimSpace(out)}, nil
+}
+
+// Root returns the work tree root.
+func (r *Repo) Root() string {
+	return r.root
+}
+
+// CommitPaths stages exactly the given paths (including deletions of tracked
+// files) and commits only those paths, leaving anything else the user has
+// staged untouched. Paths that neither exist nor are tracked are ignored.
+// Returns the short hash of the new commit.
+func (r *Repo) CommitPaths(ctx context.Context, paths []string, message string) (string, error) {
+	var pathspecs []string
+	for _, path := range paths {
+		if path == "" {
+			continue
+		}
+		rel, err := r.relative(path)
+		if err != nil {
+			return "", err
+		}
+		if _, statErr := os.Stat(path); statErr != nil && !r.tracked(ctx, rel) {
+			continue
+		}
+		pathspecs = append(pathspecs, rel)
+	}
+	if len(pathspecs) == 0 {
+		return "", ErrNothingToCommit
+	}
+
+	if _, err := run(ctx, r.root, append([]string{"add", "-A", "--"}, pathspecs...)...); err != nil {
+		return "", err
+	}
+	if _, err := run(ctx, r.root, append([]string{"diff", "--cached", "--quiet", "--"}, pathspecs...)...); err == nil {
+		return "", ErrNothingToCommit
+	}
+	if _, err := run(ctx, r.root, append([]string{"commit", "--quiet", "-m", message, "--"}, pathspecs...)...); err != nil {
+		return "", err
+	}
+
+	hash, err := run(ctx, r.root, "rev-parse", "--short", "HEAD")
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(hash), nil
+}
+
+// Push pushes the current branch to remote.
+func (r *Repo) Push(ctx context.Context, remote string) error {
+	_, err := run(ctx, r.root, "push", "--quiet", remote, "HEAD")
+	return err
+}
+
+func (r *Repo) relative(path string) (string, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+	// Resolve symlinks on both sides so /tmp vs /private/tmp style aliases match
+	root := r.root
+	if resolved, err := filepath.EvalSymlinks(root); err == nil {
+		root = resolved
+	}
+	if resolved, err := filepath.EvalSymlinks(filepath.Dir(abs)); err == nil {
+		abs = filepath.Join(resolved, filepath.Base(abs))
+	}
+
+	rel, err := filepath.Rel(root, abs)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("%s is outside the repository at %s", path, r.root)
+	}
+	return filepath.ToSlash(rel), nil
+}
+
+func (r *Repo) tracked(ctx context.Context, rel string) bool {
+	_, err := run(ctx, r.root, "ls-files", "--error-unmatch", "--", rel)
+	return err == nil
+}
+
+func run(ctx context.Context, dir string, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = dir
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		detail := strings.TrimSpace(stderr.String())
+		if detail == "" {
+			return "", fmt.Errorf("git %s: %w", args[0], err)
+		}
+		return "", fmt.Errorf("git %s: %s", args[0], detail)
+	}
+	return stdout.String(), nil
+}
@@ -0,0 +1,124 @@
+package gitrepo
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func initRepo(t *testing.T) string {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	t.Setenv("GIT_AUTHOR_NAME", "Tester")
+	t.Setenv("GIT_AUTHOR_EMAIL", "tester@example.com")
+	t.Setenv("GIT_COMMITTER_NAME", "Tester")
+	t.Setenv("GIT_COMMITTER_EMAIL", "tester@example.com")
+
+	dir := t.TempDir()
+	gitRun(t, dir, "init", "--quiet")
+	return dir
+}
+
+func gitRun(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+	out, err := run(context.Background(), dir, args...)
+	if err != nil {
+		t.Fatalf("git %v failed: %v", args, err)
+	}
+	return out
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatalf("mkdir failed: %v", err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("write failed: %v", err)
+	}
+}
+
+func TestOpenOutsideRepository(t *testing.T) {
+	if _, err := Open(context.Background(), t.TempDir()); !errors.Is(err, ErrNotRepository) {
+		t.Fatalf("expected ErrNotRepository, got %v", err)
+	}
+}
+
+func TestCommitPathsCommitsOnlyGivenPaths(t *testing.T) {
+	root := initRepo(t)
+	meetingDir := filepath.Join(root, "Acme", "2026-02-04")
+	transcript := filepath.Join(meetingDir, "transcript.txt")
+	writeFile(t, transcript, "transcript")
+	gitRun(t, root, "add", ".")
+	gitRun(t, root, "commit", "--quiet", "-m", "initial")
+
+	// Simulate a run: new summary, renamed transcript, plus unrelated staged work.
+	summaryPath := filepath.Join(meetingDir, "summary.md")
+	renamed := filepath.Join(meetingDir, "2026-02-04-transcript.txt")
+	writeFile(t, summaryPath, "summary")
+	if err := os.Rename(transcript, renamed); err != nil {
+		t.Fatalf("rename failed: %v", err)
+	}
+	unrelated := filepath.Join(root, "notes.md")
+	writeFile(t, unrelated, "unrelated")
+	gitRun(t, root, "add", "notes.md")
+
+	repo, err := Open(context.Background(), meetingDir)
+	if err != nil {
+		t.Fatalf("open failed: %v", err)
+	}
+	missing := filepath.Join(meetingDir, "never-existed.md")
+	hash, err := repo.CommitPaths(context.Background(), []string{summaryPath, "", transcript, renamed, missing}, "Add Acme summary")
+	if err != nil {
+		t.Fatalf("commit failed: %v", err)
+	}
+	if hash == "" {
+		t.Fatal("expected commit hash")
+	}
+
+	files := gitRun(t, root, "show", "--name-status", "--no-renames", "--format=%s", "HEAD")
+	for _, expected := range []string{"Add Acme summary", "A\tAcme/2026-02-04/summary.md", "A\tAcme/2026-02-04/2026-02-04-transcript.txt", "D\tAcme/2026-02-04/transcript.txt"} {
+		if !strings.Contains(files, expected) {
+			t.Errorf("expected %q in commit:\n%s", expected, files)
+		}
+	}
+	if strings.Contains(files, "notes.md") {
+		t.Errorf("unrelated staged file was committed:\n%s", files)
+	}
+
+	if _, err := repo.CommitPaths(context.Background(), []string{summaryPath}, "again"); !errors.Is(err, ErrNothingToCommit) {
+		t.Fatalf("expected ErrNothingToCommit, got %v", err)
+	}
+}
+
+func TestPush(t *testing.T) {
+	root := initRepo(t)
+	remote := t.TempDir()
+	gitRun(t, remote, "init", "--quiet", "--bare")
+	gitRun(t, root, "remote", "add", "origin", remote)
+
+	summaryPath := filepath.Join(root, "summary.md")
+	writeFile(t, summaryPath, "summary")
+
+	repo, err := Open(context.Background(), root)
+	if err != nil {
+		t.Fatalf("open failed: %v", err)
+	}
+	hash, err := repo.CommitPaths(context.Background(), []string{summaryPath}, "Add summary")
+	if err != nil {
+		t.Fatalf("commit failed: %v", err)
+	}
+	if err := repo.Push(context.Background(), "origin"); err != nil {
+		t.Fatalf("push failed: %v", err)
+	}
+
+	if log := gitRun(t, remote, "log", "--format=%h", "--all"); !strings.Contains(log, hash) {
+		t.Errorf("expected %s in remote log, got %q", hash, log)
+	}
+}
@@ -0,0 +1,288 @@
+package history
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aymanbagabas/go-udiff"
+	"github.com/bashfulrobot/meetsum/internal/summary"
+)
+
+// DirName is the history folder inside the meeting state directory.
+const DirName = "history"
+
+// versionFile holds a version's metadata inside its folder.
+const versionFile = "version.json"
+
+// generationFile records who produced the current summary, so the metadata
+// travels with it when it is archived.
+const generationFile = "generation.json"
+
+// idLayout names version folders so they sort chronologically.
+const idLayout = "20060102T150405.000Z"
+
+// Current is the version reference for the files in the meeting directory.
+const Current = "current"
+
+// ErrNotFound is returned when a version reference matches nothing.
+var ErrNotFound = errors.New("version not found")
+
+// Generation describes how a summary was produced.
+type Generation struct {
+	Provider    string    `json:"provider,omitempty"`
+	GeneratedAt time.Time `json:"generated_at,omitzero"`
+	// Source is what wrote the summary: run, regenerate or restore.
+	Source string `json:"source,omitempty"`
+}
+
+// Outputs are the paths of a meeting's summary files.
+type Outputs struct {
+	Summary string
+	Slack   string
+}
+
+// Version is one archived set of outputs. Summary and Slack hold the base
+// names of the archived files and are empty when a file was not present.
+type Version struct {
+	ID         string    `json:"id"`
+	ArchivedAt time.Time `json:"archived_at"`
+	// Reason is what replaced this version: run, regenerate, slack or restore.
+	Reason  string `json:"reason"`
+	Summary string `json:"summary,omitempty"`
+	Slack   string `json:"slack,omitempty"`
+	Generation
+	// Dir is the folder holding the archived files.
+	Dir string `json:"-"`
+}
+
+// SummaryPath returns the archived summary, or empty when there is none.
+func (v Version) SummaryPath() string {
+	return v.path(v.Summary)
+}
+
+// SlackPath returns the archived Slack summary, or empty when there is none.
+func (v Version) SlackPath() string {
+	return v.path(v.Slack)
+}
+
+func (v Version) path(name string) string {
+	if name == "" {
+		return ""
+	}
+	return filepath.Join(v.Dir, name)
+}
+
+// Root returns the history folder of a meeting directory.
+func Root(meetingDir string) string {
+	return filepath.Join(summary.StateDir(meetingDir), DirName)
+}
+
+// RecordGeneration stores the metadata of the summary that was just written.
+func RecordGeneration(meetingDir string, generation Generation) error {
+	_, err := summary.WriteStateFile(meetingDir, generationFile, generation)
+	return err
+}
+
+// LoadGeneration returns the metadata of the current summary. A summary from
+// before history was recorded has none.
+func LoadGeneration(meetingDir string) Generation {
+	var generation Generation
+	if err := summary.ReadStateFile(meetingDir, generationFile, &generation); err != nil {
+		return Generation{}
+	}
+	return generation
+}
+
+// Archive copies the existing output files into a new version folder before
+// they are overwritten. Returns false when there was nothing to archive.
+func Archive(meetingDir string, outputs Outputs, reason string) (Version, bool, error) {
+	summaryExists := fileExists(outputs.Summary)
+	slackExists := fileExists(outputs.Slack)
+	if !summaryExists && !slackExists {
+		return Version{}, false, nil
+	}
+
+	now := time.Now().UTC()
+	version := Version{
+		ID:         now.Format(idLayout),
+		ArchivedAt: now,
+		Reason:     reason,
+		Generation: LoadGeneration(meetingDir),
+	}
+	version.Dir = filepath.Join(Root(meetingDir), version.ID)
+	for suffix := 2; ; suffix++ {
+		if _, err := os.Stat(version.Dir); os.IsNotExist(err) {
+			break
+		}
+		version.ID = now.Format(idLayout) + "-" + strconv.Itoa(suffix)
+		version.Dir = filepath.Join(Root(meetingDir), version.ID)
+	}
+	if err := os.MkdirAll(version.Dir, 0755); err != nil {
+		return Version{}, false, fmt.Errorf("failed to create history folder: %w", err)
+	}
+
+	if summaryExists {
+		version.Summary = filepath.Base(outputs.Summary)
+		if err := copyFile(outputs.Summary, version.SummaryPath()); err != nil {
+			return Version{}, false, fmt.Errorf("failed to archive summary: %w", err)
+		}
+	}
+	if slackExists {
+		version.Slack = filepath.Base(outputs.Slack)
+		if err := copyFile(outputs.Slack, version.SlackPath()); err != nil {
+			return Version{}, false, fmt.Errorf("failed to archive Slack summary: %w", err)
+		}
+	}
+	if err := writeVersion(version); err != nil {
+		return Version{}, false, err
+	}
+	return version, true, nil
+}
+
+// List returns the archived versions of a meeting, newest first.
+func List(meetingDir string) ([]Version, error) {
+	entries, err := os.ReadDir(Root(meetingDir))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var versions []Version
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		version, err := readVersion(filepath.Join(Root(meetingDir), entry.Name()))
+		if err != nil {
+			continue
+		}
+		versions = append(versions, version)
+	}
+	sort.SliceStable(versions, func(i, j int) bool {
+		if !versions[i].ArchivedAt.Equal(versions[j].ArchivedAt) {
+			return versions[i].ArchivedAt.After(versions[j].ArchivedAt)
+		}
+		return versions[i].ID > versions[j].ID
+	})
+	return versions, nil
+}
+
+// Find resolves a version reference: a number from List (1 is the newest
+// archived version), a version ID or a unique ID prefix.
+func Find(meetingDir, ref string) (Version, error) {
+	versions, err := List(meetingDir)
+	if err != nil {
+		return Version{}, err
+	}
+	ref = strings.TrimSpace(ref)
+
+	if n, err := strconv.Atoi(ref); err == nil {
+		if n < 1 || n > len(versions) {
+			return Version{}, fmt.Errorf("%w: %d (there are %d archived versions)", ErrNotFound, n, len(versions))
+		}
+		return versions[n-1], nil
+	}
+
+	var matches []Version
+	for _, version := range versions {
+		if version.ID == ref {
+			return version, nil
+		}
+		if ref != "" && strings.HasPrefix(version.ID, ref) {
+			matches = append(matches, version)
+		}
+	}
+	switch len(matches) {
+	case 1:
+		return matches[0], nil
+	case 0:
+		return Version{}, fmt.Errorf("%w: %q", ErrNotFound, ref)
+	default:
+		return Version{}, fmt.Errorf("version %q is ambiguous: matches %d versions", ref, len(matches))
+	}
+}
+
+// Restore archives the current outputs and copies the version's files back
+// over them. A file missing from the version is removed so the meeting
+// matches the version exactly. Returns the archive of the replaced outputs.
+func Restore(meetingDir string, version Version, current Outputs) (Version, bool, error) {
+	if version.Summary == "" {
+		return Version{}, false, fmt.Errorf("version %s has no summary to restore", version.ID)
+	}
+	archived, ok, err := Archive(meetingDir, current, "restore")
+	if err != nil {
+		return Version{}, false, err
+	}
+
+	if err := copyFile(version.SummaryPath(), current.Summary); err != nil {
+		return archived, ok, fmt.Errorf("failed to restore summary: %w", err)
+	}
+	if version.Slack != "" {
+		if err := copyFile(version.SlackPath(), current.Slack); err != nil {
+			return archived, ok, fmt.Errorf("failed to restore Slack summary: %w", err)
+		}
+	} else if err := os.Remove(current.Slack); err != nil && !os.IsNotExist(err) {
+		return archived, ok, fmt.Errorf("failed to remove Slack summary: %w", err)
+	}
+
+	generation := version.Generation
+	generation.Source = "restore"
+	if err := RecordGeneration(meetingDir, generation); err != nil {
+		return archived, ok, err
+	}
+	return archived, ok, nil
+}
+
+func writeVersion(version Version) error {
+	data, err := json.MarshalIndent(version, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", versionFile, err)
+	}
+	if err := os.WriteFile(filepath.Join(version.Dir, versionFile), append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", versionFile, err)
+	}
+	return nil
+}
+
+func readVersion(dir string) (Version, error) {
+	data, err := os.ReadFile(filepath.Join(dir, versionFile))
+	if err != nil {
+		return Version{}, err
+	}
+	var version Version
+	if err := json.Unmarshal(data, &version); err != nil {
+		return Version{}, fmt.Errorf("failed to decode %s: %w", versionFile, err)
+	}
+	version.Dir = dir
+	return version, nil
+}
+
+func fileExists(path string) bool {
+	if path == "" {
+		return false
+	}
+	info, err := os.Stat(path)
+	return err == nil && !info.IsDir()
+}
+
+func copyFile(src, dst string) error {
+	data, err := os.ReadFile(src)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(dst, data, 0644)
+}
+
+// Diff returns a unified diff between two texts, or empty when they match.
+func Diff(oldLabel, newLabel, oldText, newText string) string {
+	return udiff.Unified(oldLabel, newLabel, oldText, newText)
+}
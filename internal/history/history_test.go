@@ -0,0 +1,143 @@
+package history
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeOutputs(t *testing.T, dir, summaryText, slackText string) Outputs {
+	t.Helper()
+	outputs := Outputs{
+		Summary: filepath.Join(dir, "summary.md"),
+		Slack:   filepath.Join(dir, "summary-slack.md"),
+	}
+	if err := os.WriteFile(outputs.Summary, []byte(summaryText), 0644); err != nil {
+		t.Fatalf("failed to write summary: %v", err)
+	}
+	if slackText != "" {
+		if err := os.WriteFile(outputs.Slack, []byte(slackText), 0644); err != nil {
+			t.Fatalf("failed to write Slack summary: %v", err)
+		}
+	}
+	return outputs
+}
+
+func readFile(t *testing.T, path string) string {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read %s: %v", path, err)
+	}
+	return string(data)
+}
+
+func TestArchiveWithoutOutputsIsNoop(t *testing.T) {
+	dir := t.TempDir()
+	_, archived, err := Archive(dir, Outputs{Summary: filepath.Join(dir, "summary.md")}, "run")
+	if err != nil || archived {
+		t.Fatalf("expected nothing to archive, got %v %v", archived, err)
+	}
+	if _, err := os.Stat(Root(dir)); !os.IsNotExist(err) {
+		t.Errorf("history folder should not be created: %v", err)
+	}
+}
+
+func TestArchiveListAndFind(t *testing.T) {
+	dir := t.TempDir()
+	outputs := writeOutputs(t, dir, "first summary", "first slack")
+	if err := RecordGeneration(dir, Generation{Provider: "gemini", Source: "run"}); err != nil {
+		t.Fatalf("record generation: %v", err)
+	}
+
+	first, archived, err := Archive(dir, outputs, "run")
+	if err != nil || !archived {
+		t.Fatalf("archive failed: %v", err)
+	}
+	if first.Provider != "gemini" || first.Source != "run" || first.Reason != "run" {
+		t.Errorf("unexpected metadata: %+v", first)
+	}
+
+	writeOutputs(t, dir, "second summary", "")
+	if err := os.Remove(outputs.Slack); err != nil {
+		t.Fatalf("remove slack: %v", err)
+	}
+	second, _, err := Archive(dir, outputs, "regenerate")
+	if err != nil {
+		t.Fatalf("archive failed: %v", err)
+	}
+	if second.ID == first.ID {
+		t.Fatalf("versions share an ID: %s", first.ID)
+	}
+	if second.Slack != "" || second.SlackPath() != "" {
+		t.Errorf("missing Slack summary should not be archived: %+v", second)
+	}
+
+	versions, err := List(dir)
+	if err != nil || len(versions) != 2 {
+		t.Fatalf("expected 2 versions, got %d (%v)", len(versions), err)
+	}
+	if versions[0].ID != second.ID || versions[1].ID != first.ID {
+		t.Errorf("versions not newest first: %s, %s", versions[0].ID, versions[1].ID)
+	}
+	if got := readFile(t, versions[1].SlackPath()); got != "first slack" {
+		t.Errorf("unexpected archived Slack summary %q", got)
+	}
+
+	if found, err := Find(dir, "2"); err != nil || found.ID != first.ID {
+		t.Errorf("Find by number = %v, %v", found.ID, err)
+	}
+	if found, err := Find(dir, second.ID); err != nil || found.ID != second.ID {
+		t.Errorf("Find by ID = %v, %v", found.ID, err)
+	}
+	if _, err := Find(dir, "3"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+	if _, err := Find(dir, "2000"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound for an unknown prefix, got %v", err)
+	}
+}
+
+func TestRestore(t *testing.T) {
+	dir := t.TempDir()
+	outputs := writeOutputs(t, dir, "old summary", "old slack")
+	if err := RecordGeneration(dir, Generation{Provider: "claude", Source: "run"}); err != nil {
+		t.Fatalf("record generation: %v", err)
+	}
+	old, _, err := Archive(dir, outputs, "run")
+	if err != nil {
+		t.Fatalf("archive failed: %v", err)
+	}
+	writeOutputs(t, dir, "new summary", "new slack")
+
+	replaced, archived, err := Restore(dir, old, outputs)
+	if err != nil || !archived {
+		t.Fatalf("restore failed: %v", err)
+	}
+	if got := readFile(t, outputs.Summary); got != "old summary" {
+		t.Errorf("summary not restored: %q", got)
+	}
+	if got := readFile(t, outputs.Slack); got != "old slack" {
+		t.Errorf("Slack summary not restored: %q", got)
+	}
+	if replaced.Reason != "restore" || readFile(t, replaced.SummaryPath()) != "new summary" {
+		t.Errorf("replaced outputs not archived: %+v", replaced)
+	}
+	if generation := LoadGeneration(dir); generation.Provider != "claude" || generation.Source != "restore" {
+		t.Errorf("unexpected generation after restore: %+v", generation)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	if diff := Diff("a", "b", "same\n", "same\n"); diff != "" {
+		t.Errorf("expected no diff, got %q", diff)
+	}
+	diff := Diff("a", "b", "one\ntwo\n", "one\nthree\n")
+	for _, want := range []string{"--- a", "+++ b", "-two", "+three"} {
+		if !strings.Contains(diff, want) {
+			t.Errorf("diff missing %q:\n%s", want, diff)
+		}
+	}
+}
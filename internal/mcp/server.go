@@ -0,0 +1,249 @@
+package mcp
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sync"
+
+	"github.com/charmbracelet/log"
+)
+
+// LatestProtocolVersion is offered to clients that request a version this
+// server does not know.
+const LatestProtocolVersion = "2025-06-18"
+
+// supportedProtocolVersions lists MCP revisions whose tool methods match ours.
+var supportedProtocolVersions = []string{"2024-11-05", "2025-03-26", LatestProtocolVersion}
+
+// JSON-RPC 2.0 error codes.
+const (
+	codeParseError     = -32700
+	codeInvalidRequest = -32600
+	codeMethodNotFound = -32601
+	codeInvalidParams  = -32602
+	codeInternalError  = -32603
+)
+
+// maxMessageSize bounds a single newline-delimited message.
+const maxMessageSize = 16 << 20
+
+// Tool is an MCP tool. Handler errors are returned to the client as tool
+// results with isError set, so the model can see and react to them.
+type Tool struct {
+	Name        string
+	Description string
+	InputSchema map[string]any
+	Handler     func(ctx context.Context, arguments json.RawMessage) (string, error)
+}
+
+// Server speaks MCP over newline-delimited JSON-RPC 2.0, as used by the stdio
+// transport. Only the tools capability is implemented.
+type Server struct {
+	name    string
+	version string
+	tools   []Tool
+	logger  *log.Logger
+
+	writeMu sync.Mutex
+	out     io.Writer
+}
+
+// NewServer creates a server that advertises the given tools.
+func NewServer(name, version string, tools []Tool, logger *log.Logger) *Server {
+	return &Server{name: name, version: version, tools: tools, logger: logger}
+}
+
+type request struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+type response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  any             `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type textContent struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+type toolResult struct {
+	Content []textContent `json:"content"`
+	IsError bool          `json:"isError,omitempty"`
+}
+
+// Serve reads requests from in and writes responses to out until in is closed
+// or ctx is canceled. Tool calls run concurrently so pings and listings stay
+// responsive while a summary is generated; Serve waits for them before returning.
+func (s *Server) Serve(ctx context.Context, in io.Reader, out io.Writer) error {
+	s.out = out
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	reader := bufio.NewReaderSize(in, 64*1024)
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		line, err := readLine(reader)
+		if len(bytes.TrimSpace(line)) > 0 {
+			var req request
+			if decodeErr := json.Unmarshal(line, &req); decodeErr != nil {
+				s.write(response{JSONRPC: "2.0", ID: json.RawMessage("null"), Error: &rpcError{codeParseError, "parse error: " + decodeErr.Error()}})
+			} else if req.Method == "tools/call" && len(req.ID) > 0 {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					s.handle(ctx, req)
+				}()
+			} else {
+				s.handle(ctx, req)
+			}
+		}
+
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func readLine(reader *bufio.Reader) ([]byte, error) {
+	var line []byte
+	for {
+		chunk, err := reader.ReadSlice('\n')
+		line = append(line, chunk...)
+		if len(line) > maxMessageSize {
+			return nil, fmt.Errorf("message exceeds %d bytes", maxMessageSize)
+		}
+		if !errors.Is(err, bufio.ErrBufferFull) {
+			return line, err
+		}
+	}
+}
+
+func (s *Server) handle(ctx context.Context, req request) {
+	// Notifications (no id) never get a response
+	isNotification := len(req.ID) == 0
+	if req.JSONRPC != "2.0" || req.Method == "" {
+		if !isNotification {
+			s.write(response{JSONRPC: "2.0", ID: req.ID, Error: &rpcError{codeInvalidRequest, "invalid JSON-RPC 2.0 request"}})
+		}
+		return
+	}
+
+	result, rpcErr := s.dispatch(ctx, req)
+	if isNotification {
+		return
+	}
+	if rpcErr != nil {
+		s.write(response{JSONRPC: "2.0", ID: req.ID, Error: rpcErr})
+		return
+	}
+	s.write(response{JSONRPC: "2.0", ID: req.ID, Result: result})
+}
+
+func (s *Server) dispatch(ctx context.Context, req request) (any, *rpcError) {
+	switch req.Method {
+	case "initialize":
+		var params struct {
+			ProtocolVersion string `json:"protocolVersion"`
+		}
+		if len(req.Params) > 0 {
+			if err := json.Unmarshal(req.Params, &params); err != nil {
+				return nil, &rpcError{codeInvalidParams, err.Error()}
+			}
+		}
+		version := LatestProtocolVersion
+		if slices.Contains(supportedProtocolVersions, params.ProtocolVersion) {
+			version = params.ProtocolVersion
+		}
+		return map[string]any{
+			"protocolVersion": version,
+			"capabilities":    map[string]any{"tools": map[string]any{}},
+			"serverInfo":      map[string]string{"name": s.name, "version": s.version},
+		}, nil
+	case "ping":
+		return map[string]any{}, nil
+	case "tools/list":
+		tools := make([]map[string]any, 0, len(s.tools))
+		for _, tool := range s.tools {
+			tools = append(tools, map[string]any{
+				"name":        tool.Name,
+				"description": tool.Description,
+				"inputSchema": tool.InputSchema,
+			})
+		}
+		return map[string]any{"tools": tools}, nil
+	case "tools/call":
+		return s.callTool(ctx, req.Params)
+	default:
+		if len(req.ID) == 0 {
+			// Unknown notifications such as notifications/initialized are ignored
+			return nil, nil
+		}
+		return nil, &rpcError{codeMethodNotFound, fmt.Sprintf("method not found: %s", req.Method)}
+	}
+}
+
+func (s *Server) callTool(ctx context.Context, raw json.RawMessage) (any, *rpcError) {
+	var params struct {
+		Name      string          `json:"name"`
+		Arguments json.RawMessage `json:"arguments"`
+	}
+	if err := json.Unmarshal(raw, &params); err != nil {
+		return nil, &rpcError{codeInvalidParams, err.Error()}
+	}
+
+	index := slices.IndexFunc(s.tools, func(tool Tool) bool { return tool.Name == params.Name })
+	if index < 0 {
+		return nil, &rpcError{codeInvalidParams, fmt.Sprintf("unknown tool: %s", params.Name)}
+	}
+	if len(params.Arguments) == 0 || string(params.Arguments) == "null" {
+		params.Arguments = json.RawMessage("{}")
+	}
+
+	if s.logger != nil {
+		s.logger.Info("tool call", "tool", params.Name)
+	}
+	text, err := s.tools[index].Handler(ctx, params.Arguments)
+	if err != nil {
+		if s.logger != nil {
+			s.logger.Warn("tool call failed", "tool", params.Name, "error", err)
+		}
+		return toolResult{Content: []textContent{{Type: "text", Text: err.Error()}}, IsError: true}, nil
+	}
+	return toolResult{Content: []textContent{{Type: "text", Text: text}}}, nil
+}
+
+func (s *Server) write(resp response) {
+	data, err := json.Marshal(resp)
+	if err != nil {
+		data, _ = json.Marshal(response{JSONRPC: "2.0", ID: resp.ID, Error: &rpcError{codeInternalError, err.Error()}})
+	}
+
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	if _, err := s.out.Write(append(data, '\n')); err != nil && s.logger != nil {
+		s.logger.Error("failed to write MCP response", "error", err)
+	}
+}
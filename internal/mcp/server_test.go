@@ -0,0 +1,189 @@
+package mcp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/bashfulrobot/meetsum/config"
+	"github.com/bashfulrobot/meetsum/internal/app"
+)
+
+type testResponse struct {
+	ID     int             `json:"id"`
+	Result json.RawMessage `json:"result"`
+	Error  *rpcError       `json:"error"`
+}
+
+func TestServeProtocol(t *testing.T) {
+	cfg := newTestConfig(t, "echo")
+	input := strings.Join([]string{
+		`{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"2024-11-05","capabilities":{},"clientInfo":{"name":"test","version":"1"}}}`,
+		`{"jsonrpc":"2.0","method":"notifications/initialized"}`,
+		`{"jsonrpc":"2.0","id":2,"method":"tools/list"}`,
+		`{"jsonrpc":"2.0","id":3,"method":"ping"}`,
+		`{"jsonrpc":"2.0","id":4,"method":"resources/list"}`,
+		`{"jsonrpc":"2.0","id":5,"method":"tools/call","params":{"name":"nope","arguments":{}}}`,
+		`not json`,
+	}, "\n") + "\n"
+
+	responses := map[int]testResponse{}
+	for _, response := range serve(t, cfg, input) {
+		responses[response.ID] = response
+	}
+	if len(responses) != 6 {
+		t.Fatalf("expected 6 responses (no reply to the notification), got %d", len(responses))
+	}
+
+	var initResult struct {
+		ProtocolVersion string `json:"protocolVersion"`
+		ServerInfo      struct {
+			Name string `json:"name"`
+		} `json:"serverInfo"`
+	}
+	mustUnmarshal(t, responses[1].Result, &initResult)
+	if initResult.ProtocolVersion != "2024-11-05" || initResult.ServerInfo.Name != "meetsum" {
+		t.Errorf("unexpected initialize result %+v", initResult)
+	}
+
+	var listResult struct {
+		Tools []struct {
+			Name string `json:"name"`
+		} `json:"tools"`
+	}
+	mustUnmarshal(t, responses[2].Result, &listResult)
+	var names []string
+	for _, tool := range listResult.Tools {
+		names = append(names, tool.Name)
+	}
+	if strings.Join(names, ",") != "summarize_meeting,list_meetings,get_summary,validate_meeting_dir" {
+		t.Errorf("unexpected tools %v", names)
+	}
+
+	if responses[3].Error != nil {
+		t.Errorf("expected ping to succeed, got %+v", responses[3].Error)
+	}
+	// The malformed line is answered with a null id
+	if responses[0].Error == nil || responses[0].Error.Code != codeParseError {
+		t.Errorf("expected a parse error for the malformed line, got %+v", responses[0])
+	}
+	if responses[4].Error == nil || responses[4].Error.Code != codeMethodNotFound {
+		t.Errorf("expected method not found, got %+v", responses[4])
+	}
+	if responses[5].Error == nil || responses[5].Error.Code != codeInvalidParams {
+		t.Errorf("expected invalid params for an unknown tool, got %+v", responses[5])
+	}
+}
+
+func TestTools(t *testing.T) {
+	commandDir := t.TempDir()
+	script := "#!/usr/bin/env bash\ncat >/dev/null\ncat <<'OUT'\n*_2026-02-04 ACME CADENCE CALL SUMMARY_*\n\n*HIGHLIGHTS*\n\n- Shipped it.\nOUT\n"
+	if err := os.WriteFile(filepath.Join(commandDir, "fake-ai-mcp"), []byte(script), 0755); err != nil {
+		t.Fatalf("failed to write fake AI command: %v", err)
+	}
+	t.Setenv("PATH", commandDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	cfg := newTestConfig(t, "fake-ai-mcp")
+	root := filepath.Join(t.TempDir(), "Customers")
+	meetingDir := filepath.Join(root, "Acme", "2026-02-04")
+	if err := os.MkdirAll(meetingDir, 0755); err != nil {
+		t.Fatalf("failed to create meeting dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(meetingDir, "transcript.txt"), []byte("transcript"), 0644); err != nil {
+		t.Fatalf("failed to write transcript: %v", err)
+	}
+	cfg.Paths.FileBrowserRootDir = root
+
+	call := func(id int, tool string, arguments map[string]any) (string, bool) {
+		t.Helper()
+		params, _ := json.Marshal(map[string]any{"name": tool, "arguments": arguments})
+		line, _ := json.Marshal(map[string]any{"jsonrpc": "2.0", "id": id, "method": "tools/call", "params": json.RawMessage(params)})
+		responses := serve(t, cfg, string(line)+"\n")
+		if len(responses) != 1 || responses[0].Error != nil {
+			t.Fatalf("unexpected %s response %+v", tool, responses)
+		}
+		var result toolResult
+		mustUnmarshal(t, responses[0].Result, &result)
+		return result.Content[0].Text, result.IsError
+	}
+
+	text, isError := call(1, "validate_meeting_dir", map[string]any{"meeting_dir": meetingDir})
+	if isError || !strings.Contains(text, "Transcript: transcript.txt") || !strings.Contains(text, "ready to summarize") {
+		t.Errorf("unexpected validation output:\n%s", text)
+	}
+
+	text, isError = call(2, "list_meetings", map[string]any{"pending_only": true})
+	if isError || !strings.Contains(text, `"customer": "Acme"`) {
+		t.Errorf("expected the pending meeting to be listed:\n%s", text)
+	}
+
+	if text, isError = call(3, "get_summary", map[string]any{"meeting_dir": meetingDir}); !isError || !strings.Contains(text, "no summary found") {
+		t.Errorf("expected a tool error before summarizing, got %q", text)
+	}
+
+	text, isError = call(4, "summarize_meeting", map[string]any{"meeting_dir": meetingDir, "user_name": "Tester"})
+	if isError || !strings.Contains(text, "*HIGHLIGHTS*") {
+		t.Fatalf("unexpected summarize output:\n%s", text)
+	}
+
+	text, isError = call(5, "get_summary", map[string]any{"meeting_dir": meetingDir, "slack": true})
+	if isError || !strings.Contains(text, "*FULL MEETING SUMMARY*") {
+		t.Errorf("expected the Slack summary, got:\n%s", text)
+	}
+
+	text, isError = call(6, "list_meetings", map[string]any{"pending_only": true})
+	if isError || strings.Contains(text, "Acme") {
+		t.Errorf("expected no pending meetings after summarizing:\n%s", text)
+	}
+
+	if _, isError = call(7, "summarize_meeting", map[string]any{"meeting_dir": "/does/not/exist"}); !isError {
+		t.Error("expected an error for a missing directory")
+	}
+}
+
+func serve(t *testing.T, cfg *config.Config, input string) []testResponse {
+	t.Helper()
+	var out bytes.Buffer
+	server := NewServer("meetsum", "test", Tools(cfg, app.NewService(cfg, nil)), nil)
+	if err := server.Serve(context.Background(), strings.NewReader(input), &out); err != nil {
+		t.Fatalf("serve failed: %v", err)
+	}
+
+	var responses []testResponse
+	for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var response testResponse
+		mustUnmarshal(t, json.RawMessage(line), &response)
+		responses = append(responses, response)
+	}
+	return responses
+}
+
+func mustUnmarshal(t *testing.T, data json.RawMessage, value any) {
+	t.Helper()
+	if err := json.Unmarshal(data, value); err != nil {
+		t.Fatalf("failed to decode %s: %v", data, err)
+	}
+}
+
+func newTestConfig(t *testing.T, command string) *config.Config {
+	t.Helper()
+
+	automationDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(automationDir, "instructions.md"), []byte("Meeting instructions"), 0644); err != nil {
+		t.Fatalf("failed to write instructions file: %v", err)
+	}
+
+	cfg := &config.Config{}
+	cfg.Paths.AutomationDir = automationDir
+	cfg.Paths.InstructionsFile = "instructions.md"
+	cfg.Files.PovInput = "pov-input.md"
+	cfg.AI.Command = command
+	return cfg
+}
@@ -0,0 +1,304 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/bashfulrobot/meetsum/config"
+	"github.com/bashfulrobot/meetsum/internal/app"
+	"github.com/bashfulrobot/meetsum/internal/meetings"
+	"github.com/bashfulrobot/meetsum/internal/summary"
+)
+
+// Tools returns the meetsum tools backed by service and cfg.
+func Tools(cfg *config.Config, service *app.Service) []Tool {
+	meetingDirProperty := map[string]any{
+		"type":        "string",
+		"description": "Absolute path to the meeting directory, e.g. ~/Documents/Company/Customers/Acme/2025-10-01",
+	}
+
+	return []Tool{
+		{
+			Name: "summarize_meeting",
+			Description: "Generate the meeting summary and Slack mini summary for a meeting directory containing exactly one .txt transcript. " +
+				"Runs the configured AI command and may take a few minutes. Returns the summary text and the files written.",
+			InputSchema: objectSchema(map[string]any{
+				"meeting_dir":   meetingDirProperty,
+				"user_name":     map[string]any{"type": "string", "description": "Name to write the summary as; defaults to user.name from the meetsum config"},
+				"meeting_type":  map[string]any{"type": "string", "description": "Meeting type such as discovery or qbr; defaults to the directory's type file or meetings.default_type"},
+				"recording_url": map[string]any{"type": "string", "description": "Recording link to fill into the summary"},
+			}, "meeting_dir"),
+			Handler: func(_ context.Context, arguments json.RawMessage) (string, error) {
+				return summarizeMeeting(cfg, service, arguments)
+			},
+		},
+		{
+			Name:        "list_meetings",
+			Description: "List meeting directories under the customer root with their customer, date and whether a transcript, summary and Slack summary exist.",
+			InputSchema: objectSchema(map[string]any{
+				"root":         map[string]any{"type": "string", "description": "Directory to scan; defaults to paths.file_browser_root_dir"},
+				"customer":     map[string]any{"type": "string", "description": "Only list meetings for this customer (case-insensitive)"},
+				"pending_only": map[string]any{"type": "boolean", "description": "Only list meetings that have a transcript but no summary"},
+			}),
+			Handler: func(_ context.Context, arguments json.RawMessage) (string, error) {
+				return listMeetings(cfg, arguments)
+			},
+		},
+		{
+			Name:        "get_summary",
+			Description: "Read the generated summary, or the Slack mini summary, for a meeting directory.",
+			InputSchema: objectSchema(map[string]any{
+				"meeting_dir": meetingDirProperty,
+				"slack":       map[string]any{"type": "boolean", "description": "Return the Slack mini summary instead of the full summary"},
+			}, "meeting_dir"),
+			Handler: func(_ context.Context, arguments json.RawMessage) (string, error) {
+				return getSummary(cfg, arguments)
+			},
+		},
+		{
+			Name:        "validate_meeting_dir",
+			Description: "Check whether a meeting directory is ready to summarize: exactly one .txt transcript, an instructions file for its meeting type, and which optional context files were found.",
+			InputSchema: objectSchema(map[string]any{
+				"meeting_dir": meetingDirProperty,
+			}, "meeting_dir"),
+			Handler: func(_ context.Context, arguments json.RawMessage) (string, error) {
+				return validateMeetingDir(cfg, arguments)
+			},
+		},
+	}
+}
+
+func objectSchema(properties map[string]any, required ...string) map[string]any {
+	schema := map[string]any{
+		"type":       "object",
+		"properties": properties,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
+
+type meetingArguments struct {
+	MeetingDir   string `json:"meeting_dir"`
+	UserName     string `json:"user_name"`
+	MeetingType  string `json:"meeting_type"`
+	RecordingURL string `json:"recording_url"`
+	Slack        bool   `json:"slack"`
+}
+
+// decodeMeetingArguments parses arguments and resolves meeting_dir to an
+// existing absolute directory.
+func decodeMeetingArguments(raw json.RawMessage) (meetingArguments, error) {
+	var arguments meetingArguments
+	if err := json.Unmarshal(raw, &arguments); err != nil {
+		return arguments, fmt.Errorf("invalid arguments: %w", err)
+	}
+
+	dir, err := resolveDir(arguments.MeetingDir)
+	if err != nil {
+		return arguments, err
+	}
+	arguments.MeetingDir = dir
+	return arguments, nil
+}
+
+func resolveDir(dir string) (string, error) {
+	dir = strings.TrimSpace(dir)
+	if dir == "" {
+		return "", fmt.Errorf("meeting_dir is required")
+	}
+	if strings.HasPrefix(dir, "~/") {
+		if home, err := os.UserHomeDir(); err == nil {
+			dir = filepath.Join(home, dir[2:])
+		}
+	}
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve %s: %w", dir, err)
+	}
+	if info, err := os.Stat(absDir); err != nil || !info.IsDir() {
+		return "", fmt.Errorf("directory %s does not exist", dir)
+	}
+	return absDir, nil
+}
+
+func summarizeMeeting(cfg *config.Config, service *app.Service, raw json.RawMessage) (string, error) {
+	arguments, err := decodeMeetingArguments(raw)
+	if err != nil {
+		return "", err
+	}
+	userName := strings.TrimSpace(arguments.UserName)
+	if userName == "" {
+		userName = strings.TrimSpace(cfg.User.Name)
+	}
+	if userName == "" {
+		return "", fmt.Errorf("user_name is required because user.name is not configured")
+	}
+
+	if _, err := service.Preflight(); err != nil {
+		return "", err
+	}
+	session, err := service.Prepare(app.RunRequest{
+		UserName:     userName,
+		MeetingDir:   arguments.MeetingDir,
+		MeetingType:  arguments.MeetingType,
+		RecordingURL: arguments.RecordingURL,
+	})
+	if err != nil {
+		return "", err
+	}
+	result, err := session.Run()
+	if err != nil {
+		return "", fmt.Errorf("failed to generate summary: %w", err)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Summary file: %s\n", result.OutputPath)
+	if result.SlackOutputPath != "" {
+		fmt.Fprintf(&b, "Slack summary: %s\n", result.SlackOutputPath)
+	}
+	if result.RenamedTranscript != "" {
+		fmt.Fprintf(&b, "Transcript renamed to: %s\n", result.RenamedTranscript)
+	}
+	for _, warning := range []string{
+		result.RenameWarning, result.SlackWarning, result.WebhookWarning, result.GitWarning,
+		result.RecordingWarning, result.EmailWarning, result.NoteWarning, result.SlackPostWarning,
+	} {
+		if warning != "" {
+			fmt.Fprintf(&b, "Warning: %s\n", warning)
+		}
+	}
+	for _, issue := range result.LintIssues {
+		fmt.Fprintf(&b, "Formatting issue: %s\n", issue)
+	}
+	fmt.Fprintf(&b, "\n%s\n", strings.TrimSpace(result.Summary))
+	return b.String(), nil
+}
+
+func listMeetings(cfg *config.Config, raw json.RawMessage) (string, error) {
+	var arguments struct {
+		Root        string `json:"root"`
+		Customer    string `json:"customer"`
+		PendingOnly bool   `json:"pending_only"`
+	}
+	if err := json.Unmarshal(raw, &arguments); err != nil {
+		return "", fmt.Errorf("invalid arguments: %w", err)
+	}
+
+	root := arguments.Root
+	if strings.TrimSpace(root) == "" {
+		root = cfg.Paths.FileBrowserRootDir
+	}
+	root, err := resolveDir(root)
+	if err != nil {
+		return "", err
+	}
+
+	found, err := meetings.Scan(cfg, root)
+	if err != nil {
+		return "", err
+	}
+
+	listed := make([]meetings.Meeting, 0, len(found))
+	for _, meeting := range found {
+		if arguments.Customer != "" && !strings.EqualFold(meeting.Customer, strings.TrimSpace(arguments.Customer)) {
+			continue
+		}
+		if arguments.PendingOnly && !meeting.Pending() {
+			continue
+		}
+		listed = append(listed, meeting)
+	}
+
+	data, err := json.MarshalIndent(map[string]any{"root": root, "meetings": listed}, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func getSummary(cfg *config.Config, raw json.RawMessage) (string, error) {
+	arguments, err := decodeMeetingArguments(raw)
+	if err != nil {
+		return "", err
+	}
+
+	meeting, err := meetings.Inspect(cfg, arguments.MeetingDir)
+	if err != nil {
+		return "", err
+	}
+	path := meeting.Summary
+	if arguments.Slack {
+		path = meeting.SlackSummary
+	}
+	if path == "" {
+		kind := "summary"
+		if arguments.Slack {
+			kind = "Slack summary"
+		}
+		return "", fmt.Errorf("no %s found in %s; run summarize_meeting first", kind, arguments.MeetingDir)
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	return string(content), nil
+}
+
+func validateMeetingDir(cfg *config.Config, raw json.RawMessage) (string, error) {
+	arguments, err := decodeMeetingArguments(raw)
+	if err != nil {
+		return "", err
+	}
+
+	dirConfig, err := cfg.ForDir(arguments.MeetingDir)
+	if err != nil {
+		return "", err
+	}
+	processor := summary.NewProcessor(dirConfig.Config, nil)
+	processor.SetMeetingDir(arguments.MeetingDir)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Meeting directory: %s\n", arguments.MeetingDir)
+	fmt.Fprintf(&b, "Meeting type: %s\n", processor.MeetingType())
+
+	ready := true
+	if transcriptPath, err := summary.FindSingleTranscriptCandidate(arguments.MeetingDir); err != nil {
+		ready = false
+		fmt.Fprintf(&b, "Transcript: MISSING (%v)\n", err)
+	} else {
+		fmt.Fprintf(&b, "Transcript: %s\n", filepath.Base(transcriptPath))
+	}
+	if err := processor.ValidateRequiredFiles(); err != nil && ready {
+		ready = false
+		fmt.Fprintf(&b, "Instructions: MISSING (%v)\n", err)
+	} else if ready {
+		b.WriteString("Instructions: found\n")
+	}
+
+	optional := processor.GetOptionalFiles()
+	if len(optional) == 0 {
+		b.WriteString("Optional context files: none\n")
+	} else {
+		fmt.Fprintf(&b, "Optional context files: %s\n", strings.Join(optional, ", "))
+	}
+
+	if summaryPath, err := processor.SummaryPath(); err == nil {
+		if _, statErr := os.Stat(summaryPath); statErr == nil {
+			fmt.Fprintf(&b, "Existing summary: %s (running summarize_meeting overwrites it)\n", filepath.Base(summaryPath))
+		}
+	}
+
+	if ready {
+		b.WriteString("Status: ready to summarize\n")
+	} else {
+		b.WriteString("Status: not ready\n")
+	}
+	return b.String(), nil
+}
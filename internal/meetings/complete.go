@@ -0,0 +1,103 @@
+package meetings
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/bashfulrobot/meetsum/config"
+)
+
+// Candidate is a directory suggested by shell completion.
+type Candidate struct {
+	Path string
+	// Meeting is true for meeting directories and false for folders that
+	// group them, such as customer folders.
+	Meeting bool
+	// Pending counts meetings with a transcript but no summary: 0 or 1 for a
+	// meeting directory, the pending meetings directly inside a folder.
+	Pending int
+	// Summarized is true for meeting directories that already have a summary.
+	Summarized bool
+}
+
+// Complete lists the subdirectories of dir whose names start with prefix,
+// for shell completion. Pending meetings come first, then folders holding
+// pending meetings (most first), then everything else. Meetings are listed
+// newest first and folders alphabetically. Hidden directories are skipped.
+func Complete(cfg *config.Config, dir, prefix string) []Candidate {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+
+	var candidates []Candidate
+	for _, entry := range entries {
+		name := entry.Name()
+		if !entry.IsDir() || strings.HasPrefix(name, ".") || !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		candidates = append(candidates, inspectCandidate(cfg, filepath.Join(dir, name)))
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if rank(a) != rank(b) {
+			return rank(a) < rank(b)
+		}
+		if a.Pending != b.Pending {
+			return a.Pending > b.Pending
+		}
+		if a.Meeting != b.Meeting {
+			return a.Meeting
+		}
+		if a.Meeting {
+			return filepath.Base(a.Path) > filepath.Base(b.Path)
+		}
+		return strings.ToLower(filepath.Base(a.Path)) < strings.ToLower(filepath.Base(b.Path))
+	})
+	return candidates
+}
+
+// rank groups candidates: pending meetings, folders with pending meetings,
+// then the rest.
+func rank(candidate Candidate) int {
+	switch {
+	case candidate.Meeting && candidate.Pending > 0:
+		return 0
+	case candidate.Pending > 0:
+		return 1
+	default:
+		return 2
+	}
+}
+
+func inspectCandidate(cfg *config.Config, path string) Candidate {
+	if isMeetingDir(path) {
+		candidate := Candidate{Path: path, Meeting: true}
+		if meeting, err := Inspect(cfg, path); err == nil {
+			if meeting.Pending() {
+				candidate.Pending = 1
+			}
+			candidate.Summarized = meeting.HasSummary()
+		}
+		return candidate
+	}
+
+	candidate := Candidate{Path: path}
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return candidate
+	}
+	for _, entry := range entries {
+		child := filepath.Join(path, entry.Name())
+		if !entry.IsDir() || strings.HasPrefix(entry.Name(), ".") || !isMeetingDir(child) {
+			continue
+		}
+		if meeting, err := Inspect(cfg, child); err == nil && meeting.Pending() {
+			candidate.Pending++
+		}
+	}
+	return candidate
+}
@@ -0,0 +1,65 @@
+package meetings
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/bashfulrobot/meetsum/config"
+)
+
+func TestComplete(t *testing.T) {
+	root := filepath.Join(t.TempDir(), "Customers")
+	files := map[string]string{
+		"Acme/2025-10-01/transcript.txt":                              "t",
+		"Acme/2025-10-01/2025-10-01-Acme-cadence-call-summary.md":     "s",
+		"Acme/2025-10-02/transcript.txt":                              "t",
+		"Acme/2025-10-03/notes.md":                                    "n",
+		"Beta/2025-09-01/transcript.txt":                              "t",
+		"Beta/2025-09-02/transcript.txt":                              "t",
+		"Cobalt/2025-08-01/transcript.txt":                            "t",
+		"Cobalt/2025-08-01/2025-08-01-Cobalt-cadence-call-summary.md": "s",
+		".archive/2025-01-01/transcript.txt":                          "t",
+	}
+	for name, content := range files {
+		path := filepath.Join(root, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("failed to create %s: %v", filepath.Dir(path), err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+	}
+	cfg := &config.Config{}
+
+	customers := Complete(cfg, root, "")
+	var names []string
+	for _, candidate := range customers {
+		names = append(names, filepath.Base(candidate.Path))
+	}
+	if len(names) != 3 || names[0] != "Beta" || names[1] != "Acme" || names[2] != "Cobalt" {
+		t.Fatalf("customers should be ordered by pending meetings, got %v", names)
+	}
+	if customers[0].Pending != 2 || customers[0].Meeting {
+		t.Errorf("unexpected Beta candidate: %+v", customers[0])
+	}
+
+	acme := Complete(cfg, filepath.Join(root, "Acme"), "2025-10")
+	names = nil
+	for _, candidate := range acme {
+		names = append(names, filepath.Base(candidate.Path))
+	}
+	if len(names) != 3 || names[0] != "2025-10-02" || names[1] != "2025-10-03" || names[2] != "2025-10-01" {
+		t.Fatalf("pending meeting should come first, then newest, got %v", names)
+	}
+	if !acme[0].Meeting || acme[0].Pending != 1 || !acme[2].Summarized {
+		t.Errorf("unexpected meeting candidates: %+v", acme)
+	}
+
+	if got := Complete(cfg, root, "Be"); len(got) != 1 || filepath.Base(got[0].Path) != "Beta" {
+		t.Errorf("prefix should filter candidates, got %+v", got)
+	}
+	if got := Complete(cfg, filepath.Join(root, "missing"), ""); got != nil {
+		t.Errorf("missing directory should have no candidates, got %+v", got)
+	}
+}
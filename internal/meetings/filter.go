@@ -0,0 +1,123 @@
+package meetings
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Sort keys accepted by SortBy.
+const (
+	SortCustomer = "customer"
+	SortDate     = "date"
+	SortModified = "modified"
+)
+
+// SortKeys lists the valid sort keys for help text and validation.
+var SortKeys = []string{SortCustomer, SortDate, SortModified}
+
+// Filter selects meetings by customer and date.
+type Filter struct {
+	// Customer matches case-insensitively against any part of the customer name.
+	Customer string
+	// Since drops meetings dated before it. Meetings without a date use their
+	// last modification time.
+	Since time.Time
+}
+
+// Apply returns the meetings that match the filter.
+func (f Filter) Apply(items []Meeting) []Meeting {
+	customer := strings.ToLower(strings.TrimSpace(f.Customer))
+	var matched []Meeting
+	for _, meeting := range items {
+		if customer != "" && !strings.Contains(strings.ToLower(meeting.Customer), customer) {
+			continue
+		}
+		if !f.Since.IsZero() && meetingTime(meeting).Before(f.Since) {
+			continue
+		}
+		matched = append(matched, meeting)
+	}
+	return matched
+}
+
+// SortBy sorts meetings in place by key, newest first for date and modified.
+// Ties fall back to customer, then date.
+func SortBy(items []Meeting, key string, reverse bool) error {
+	var less func(a, b Meeting) bool
+	switch key {
+	case SortCustomer:
+		less = func(a, b Meeting) bool { return compareCustomerDate(a, b) < 0 }
+	case SortDate:
+		less = func(a, b Meeting) bool {
+			if a.Date != b.Date {
+				return a.Date > b.Date
+			}
+			return compareCustomerDate(a, b) < 0
+		}
+	case SortModified:
+		less = func(a, b Meeting) bool {
+			if !a.LastModified.Equal(b.LastModified) {
+				return a.LastModified.After(b.LastModified)
+			}
+			return compareCustomerDate(a, b) < 0
+		}
+	default:
+		return fmt.Errorf("unknown sort key %q (use %s)", key, strings.Join(SortKeys, ", "))
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		if reverse {
+			return less(items[j], items[i])
+		}
+		return less(items[i], items[j])
+	})
+	return nil
+}
+
+// ParseSince parses a --since value: a date (YYYY-MM-DD) or a period back
+// from now such as 14d, 2w or 36h.
+func ParseSince(value string, now time.Time) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if date, err := time.ParseInLocation("2006-01-02", value, now.Location()); err == nil {
+		return date, nil
+	}
+
+	unit := value[len(value)-1]
+	if count, err := strconv.Atoi(value[:len(value)-1]); err == nil && count >= 0 {
+		today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+		switch unit {
+		case 'd':
+			return today.AddDate(0, 0, -count), nil
+		case 'w':
+			return today.AddDate(0, 0, -7*count), nil
+		}
+	}
+	if period, err := time.ParseDuration(value); err == nil && period >= 0 {
+		return now.Add(-period), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid --since value %q: use a date (2025-10-01) or a period (14d, 2w, 36h)", value)
+}
+
+// meetingTime is the meeting date, or its last modification when undated.
+func meetingTime(meeting Meeting) time.Time {
+	if date, err := time.ParseInLocation("2006-01-02", meeting.Date, meeting.LastModified.Location()); err == nil {
+		return date
+	}
+	return meeting.LastModified
+}
+
+func compareCustomerDate(a, b Meeting) int {
+	if !strings.EqualFold(a.Customer, b.Customer) {
+		return strings.Compare(strings.ToLower(a.Customer), strings.ToLower(b.Customer))
+	}
+	if a.Date != b.Date {
+		return strings.Compare(a.Date, b.Date)
+	}
+	return strings.Compare(a.Dir, b.Dir)
+}
@@ -0,0 +1,72 @@
+package meetings
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFilterAndSort(t *testing.T) {
+	base := time.Date(2025, 10, 10, 12, 0, 0, 0, time.Local)
+	items := []Meeting{
+		{Dir: "/c/Acme/2025-10-01", Customer: "Acme", Date: "2025-10-01", LastModified: base.Add(-time.Hour)},
+		{Dir: "/c/Acme Labs/2025-09-01", Customer: "Acme Labs", Date: "2025-09-01", LastModified: base},
+		{Dir: "/c/Zeta/2025-10-05", Customer: "Zeta", Date: "2025-10-05", LastModified: base.Add(-2 * time.Hour)},
+		{Dir: "/c/Zeta/notes", Customer: "Zeta", LastModified: base.Add(-3 * time.Hour)},
+	}
+
+	dirs := func(items []Meeting) string {
+		var names []string
+		for _, item := range items {
+			names = append(names, item.Dir)
+		}
+		return strings.Join(names, ",")
+	}
+
+	if got := dirs(Filter{Customer: "acme"}.Apply(items)); got != "/c/Acme/2025-10-01,/c/Acme Labs/2025-09-01" {
+		t.Errorf("unexpected customer filter result %s", got)
+	}
+	since := time.Date(2025, 10, 1, 0, 0, 0, 0, time.Local)
+	if got := dirs(Filter{Since: since}.Apply(items)); got != "/c/Acme/2025-10-01,/c/Zeta/2025-10-05,/c/Zeta/notes" {
+		t.Errorf("unexpected since filter result %s", got)
+	}
+
+	sorted := append([]Meeting(nil), items...)
+	if err := SortBy(sorted, SortDate, false); err != nil {
+		t.Fatalf("sort failed: %v", err)
+	}
+	if got := dirs(sorted); got != "/c/Zeta/2025-10-05,/c/Acme/2025-10-01,/c/Acme Labs/2025-09-01,/c/Zeta/notes" {
+		t.Errorf("unexpected date order %s", got)
+	}
+	if err := SortBy(sorted, SortModified, true); err != nil {
+		t.Fatalf("sort failed: %v", err)
+	}
+	if got := dirs(sorted); got != "/c/Zeta/notes,/c/Zeta/2025-10-05,/c/Acme/2025-10-01,/c/Acme Labs/2025-09-01" {
+		t.Errorf("unexpected reversed modified order %s", got)
+	}
+	if err := SortBy(sorted, "size", false); err == nil {
+		t.Error("expected an error for an unknown sort key")
+	}
+}
+
+func TestParseSince(t *testing.T) {
+	now := time.Date(2025, 10, 15, 9, 30, 0, 0, time.UTC)
+	tests := map[string]time.Time{
+		"":           {},
+		"2025-10-01": time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC),
+		"14d":        time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC),
+		"2w":         time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC),
+		"36h":        now.Add(-36 * time.Hour),
+	}
+	for value, want := range tests {
+		got, err := ParseSince(value, now)
+		if err != nil || !got.Equal(want) {
+			t.Errorf("ParseSince(%q) = %v, %v; want %v", value, got, err, want)
+		}
+	}
+	for _, value := range []string{"yesterday", "-3d", "2025-13-01"} {
+		if _, err := ParseSince(value, now); err == nil {
+			t.Errorf("expected an error for %q", value)
+		}
+	}
+}
@@ -0,0 +1,172 @@
+package meetings
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bashfulrobot/meetsum/config"
+	"github.com/bashfulrobot/meetsum/internal/summary"
+)
+
+// Meeting describes the state of one meeting directory.
+type Meeting struct {
+	Dir      string `json:"dir"`
+	Customer string `json:"customer"`
+	Date     string `json:"date,omitempty"`
+	// TranscriptPresent is true when the directory has any .txt candidate.
+	TranscriptPresent bool `json:"transcript_present"`
+	// Transcript is the transcript filename, empty unless there is exactly one candidate.
+	Transcript string `json:"transcript,omitempty"`
+	// TranscriptError explains why no transcript was selected.
+	TranscriptError string `json:"transcript_error,omitempty"`
+	// ConfigError explains why the meeting's .meetsum.yaml files could not be
+	// applied. Outputs are then looked up with the base configuration.
+	ConfigError  string    `json:"config_error,omitempty"`
+	Renamed      bool      `json:"transcript_renamed"`
+	Summary      string    `json:"summary,omitempty"`
+	SlackSummary string    `json:"slack_summary,omitempty"`
+	LastModified time.Time `json:"last_modified"`
+}
+
+// HasSummary reports whether the main summary file exists.
+func (m Meeting) HasSummary() bool {
+	return m.Summary != ""
+}
+
+// Pending reports whether the meeting has a usable transcript but no summary yet.
+func (m Meeting) Pending() bool {
+	return m.Transcript != "" && !m.HasSummary()
+}
+
+// Inspect reports the transcript and output state of a meeting directory.
+func Inspect(cfg *config.Config, dir string) (Meeting, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return Meeting{}, err
+	}
+	if !info.IsDir() {
+		return Meeting{}, fmt.Errorf("%s is not a directory", dir)
+	}
+
+	// Outputs are named with the meeting's .meetsum.yaml overrides applied. A
+	// broken override file is reported on the meeting rather than failing a scan.
+	meetingConfig, configError := cfg, ""
+	if dirConfig, err := cfg.ForDir(dir); err != nil {
+		configError = err.Error()
+	} else {
+		meetingConfig = dirConfig.Config
+	}
+	processor := summary.NewProcessor(meetingConfig, nil)
+	processor.SetMeetingDir(dir)
+	customer, _ := processor.ExtractCustomerName()
+
+	meeting := Meeting{
+		Dir:          dir,
+		Customer:     customer,
+		Date:         processor.ExtractDateFromPath(),
+		ConfigError:  configError,
+		LastModified: info.ModTime(),
+	}
+
+	candidates, err := summary.DiscoverTranscriptCandidates(dir)
+	if err != nil {
+		return Meeting{}, err
+	}
+	meeting.TranscriptPresent = len(candidates) > 0
+	if transcriptPath, err := summary.FindSingleTranscriptCandidate(dir); err == nil {
+		meeting.Transcript = filepath.Base(transcriptPath)
+		meeting.Renamed = summary.IsDatedTranscript(meeting.Transcript)
+	} else {
+		meeting.TranscriptError = err.Error()
+	}
+
+	if summaryPath, err := processor.SummaryPath(); err == nil && fileExists(summaryPath) {
+		meeting.Summary = summaryPath
+	}
+	if slackName, err := processor.GenerateSlackOutputFilename(); err == nil && fileExists(filepath.Join(dir, slackName)) {
+		meeting.SlackSummary = filepath.Join(dir, slackName)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return Meeting{}, err
+	}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		if entryInfo, err := entry.Info(); err == nil && entryInfo.ModTime().After(meeting.LastModified) {
+			meeting.LastModified = entryInfo.ModTime()
+		}
+	}
+
+	return meeting, nil
+}
+
+// Scan walks root and inspects every meeting directory: directories that
+// contain a .txt transcript candidate or are named like a date (YYYY-MM-DD).
+// Hidden directories, including the .meetsum state directory, are skipped.
+// Results are sorted by customer, then date.
+func Scan(cfg *config.Config, root string) ([]Meeting, error) {
+	if _, err := os.Stat(root); err != nil {
+		return nil, fmt.Errorf("cannot scan %s: %w", root, err)
+	}
+
+	var found []Meeting
+	err := filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if path == root {
+				return err
+			}
+			// Unreadable subdirectories are skipped rather than failing the scan
+			return fs.SkipDir
+		}
+		if !entry.IsDir() {
+			return nil
+		}
+		if path != root && strings.HasPrefix(entry.Name(), ".") {
+			return fs.SkipDir
+		}
+		if !isMeetingDir(path) {
+			return nil
+		}
+
+		meeting, err := Inspect(cfg, path)
+		if err != nil {
+			if errors.Is(err, fs.ErrPermission) {
+				return nil
+			}
+			return err
+		}
+		found = append(found, meeting)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(found, func(i, j int) bool {
+		return compareCustomerDate(found[i], found[j]) < 0
+	})
+	return found, nil
+}
+
+// isMeetingDir reports whether dir looks like a meeting directory.
+func isMeetingDir(dir string) bool {
+	if _, err := time.Parse("2006-01-02", filepath.Base(dir)); err == nil {
+		return true
+	}
+	candidates, err := summary.DiscoverTranscriptCandidates(dir)
+	return err == nil && len(candidates) > 0
+}
+
+func fileExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && !info.IsDir()
+}
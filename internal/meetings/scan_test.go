@@ -0,0 +1,142 @@
+package meetings
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/bashfulrobot/meetsum/config"
+)
+
+func TestScan(t *testing.T) {
+	root := filepath.Join(t.TempDir(), "Customers")
+	files := map[string]string{
+		"Zeta/2025-09-01/2025-09-01-transcript.txt":                     "t",
+		"Zeta/2025-09-01/2025-09-01-Zeta-cadence-call-summary.md":       "s",
+		"Zeta/2025-09-01/2025-09-01-Zeta-cadence-call-summary-slack.md": "s",
+		"Acme/2025-10-02/transcript.txt":                                "t",
+		"Acme/2025-10-01/a.txt":                                         "t",
+		"Acme/2025-10-01/b.txt":                                         "t",
+		"Acme/2025-10-03/notes.md":                                      "n",
+		"Acme/2025-10-02/.meetsum/state.txt":                            "ignored",
+		"Acme/README.md":                                                "not a meeting",
+	}
+	for name, content := range files {
+		path := filepath.Join(root, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("failed to create %s: %v", filepath.Dir(path), err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+	}
+
+	found, err := Scan(&config.Config{}, root)
+	if err != nil {
+		t.Fatalf("scan failed: %v", err)
+	}
+
+	want := []struct {
+		dir        string
+		present    bool
+		transcript string
+		summary    bool
+		slack      bool
+		renamed    bool
+		pending    bool
+	}{
+		{"Acme/2025-10-01", true, "", false, false, false, false},
+		{"Acme/2025-10-02", true, "transcript.txt", false, false, false, true},
+		{"Acme/2025-10-03", false, "", false, false, false, false},
+		{"Zeta/2025-09-01", true, "2025-09-01-transcript.txt", true, true, true, false},
+	}
+	if len(found) != len(want) {
+		t.Fatalf("expected %d meetings, got %+v", len(want), found)
+	}
+	for i, w := range want {
+		got := found[i]
+		if got.Dir != filepath.Join(root, w.dir) {
+			t.Errorf("meeting %d: expected %s, got %s", i, w.dir, got.Dir)
+			continue
+		}
+		if got.TranscriptPresent != w.present || got.Transcript != w.transcript || got.HasSummary() != w.summary ||
+			(got.SlackSummary != "") != w.slack || got.Renamed != w.renamed || got.Pending() != w.pending {
+			t.Errorf("%s: unexpected state %+v", w.dir, got)
+		}
+		if got.Date == "" || got.Customer == "" || got.LastModified.IsZero() {
+			t.Errorf("%s: expected customer, date and modification time, got %+v", w.dir, got)
+		}
+	}
+	if found[0].TranscriptError == "" {
+		t.Error("expected an explanation for the ambiguous transcript")
+	}
+}
+
+func TestScanMissingRoot(t *testing.T) {
+	if _, err := Scan(&config.Config{}, filepath.Join(t.TempDir(), "missing")); err == nil {
+		t.Error("expected an error for a missing root")
+	}
+}
+
+func TestInspectUsesDirectoryOverrides(t *testing.T) {
+	root := filepath.Join(t.TempDir(), "Customers")
+	dir := filepath.Join(root, "Acme", "2025-10-02")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatalf("failed to create %s: %v", dir, err)
+	}
+	files := map[string]string{
+		filepath.Join(root, "Acme", config.DirConfigFile): "output:\n  filename_template: \"{customer}-{date}.md\"\n",
+		filepath.Join(dir, "transcript.txt"):              "t",
+		filepath.Join(dir, "Acme-2025-10-02.md"):          "s",
+	}
+	for path, content := range files {
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("failed to write %s: %v", path, err)
+		}
+	}
+
+	cfg := &config.Config{}
+	cfg.Paths.FileBrowserRootDir = root
+	cfg.Output.FilenameTemplate = config.DefaultFilenameTemplate
+	meeting, err := Inspect(cfg, dir)
+	if err != nil {
+		t.Fatalf("inspect failed: %v", err)
+	}
+	if meeting.Summary != filepath.Join(dir, "Acme-2025-10-02.md") {
+		t.Errorf("expected the summary named by the override template, got %q", meeting.Summary)
+	}
+}
+
+func TestScanReportsBrokenDirectoryConfig(t *testing.T) {
+	root := filepath.Join(t.TempDir(), "Customers")
+	files := map[string]string{
+		"Acme/.meetsum.yaml":             "ai: [unclosed\n",
+		"Acme/2025-10-02/transcript.txt": "t",
+		"Zeta/2025-09-01/transcript.txt": "t",
+	}
+	for name, content := range files {
+		path := filepath.Join(root, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("failed to create %s: %v", filepath.Dir(path), err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+	}
+
+	cfg := &config.Config{}
+	cfg.Paths.FileBrowserRootDir = root
+	found, err := Scan(cfg, root)
+	if err != nil {
+		t.Fatalf("scan failed: %v", err)
+	}
+	if len(found) != 2 {
+		t.Fatalf("expected both meetings, got %+v", found)
+	}
+	if found[0].ConfigError == "" || !found[0].Pending() {
+		t.Errorf("expected the broken override reported on a pending meeting, got %+v", found[0])
+	}
+	if found[1].ConfigError != "" {
+		t.Errorf("unexpected config error for another customer: %s", found[1].ConfigError)
+	}
+}
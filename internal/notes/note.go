@@ -0,0 +1,131 @@
+package notes
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/bashfulrobot/meetsum/internal/summary"
+)
+
+// Metadata is rendered as YAML frontmatter at the top of a knowledge base note.
+type Metadata struct {
+	Customer         string
+	Date             string
+	Attendees        []string
+	Tags             []string
+	Provider         string
+	SourceTranscript string
+	SummaryFile      string
+	ActionItems      int
+}
+
+// Options controls where and how a note is written.
+type Options struct {
+	VaultDir         string
+	FilenameTemplate string
+	CustomerPage     string
+}
+
+// TemplateVars returns the placeholder values available to note templates.
+func (m Metadata) TemplateVars() map[string]string {
+	return map[string]string{
+		"customer": m.Customer,
+		"date":     m.Date,
+		"provider": m.Provider,
+	}
+}
+
+// Render builds the note: YAML frontmatter, a wiki-link back to the customer
+// page, then the summary content unchanged.
+func Render(meta Metadata, customerPage, content string) string {
+	var b strings.Builder
+
+	b.WriteString("---\n")
+	writeScalar(&b, "customer", meta.Customer)
+	writeScalar(&b, "date", meta.Date)
+	writeList(&b, "attendees", meta.Attendees)
+	writeList(&b, "tags", meta.Tags)
+	writeScalar(&b, "provider", meta.Provider)
+	writeScalar(&b, "source_transcript", meta.SourceTranscript)
+	writeScalar(&b, "summary_file", meta.SummaryFile)
+	fmt.Fprintf(&b, "action_items: %s\n", strconv.Itoa(meta.ActionItems))
+	b.WriteString("---\n\n")
+
+	if customerPage != "" {
+		fmt.Fprintf(&b, "Customer: [[%s]]\n\n", customerPage)
+	}
+
+	b.WriteString(strings.TrimSpace(content))
+	b.WriteString("\n")
+	return b.String()
+}
+
+// Write renders the note and stores it under the vault using the filename
+// template. Returns the written path.
+func Write(opts Options, meta Metadata, content string) (string, error) {
+	vaultDir := strings.TrimSpace(opts.VaultDir)
+	if vaultDir == "" {
+		return "", fmt.Errorf("notes.vault_dir is not configured")
+	}
+	if info, err := os.Stat(vaultDir); err != nil || !info.IsDir() {
+		return "", fmt.Errorf("notes vault directory does not exist: %s", vaultDir)
+	}
+
+	vars := meta.TemplateVars()
+	relative := summary.ExpandTemplate(opts.FilenameTemplate, vars)
+	if strings.TrimSpace(relative) == "" {
+		return "", fmt.Errorf("notes.filename_template expanded to an empty filename")
+	}
+	if filepath.Ext(relative) == "" {
+		relative += ".md"
+	}
+
+	notePath := filepath.Join(vaultDir, filepath.FromSlash(relative))
+	if !strings.HasPrefix(notePath, filepath.Clean(vaultDir)+string(filepath.Separator)) {
+		return "", fmt.Errorf("notes.filename_template escapes the vault directory: %s", relative)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(notePath), 0755); err != nil {
+		return "", fmt.Errorf("failed to create note directory: %w", err)
+	}
+
+	customerPage := summary.ExpandTemplate(opts.CustomerPage, vars)
+	if err := os.WriteFile(notePath, []byte(Render(meta, customerPage, content)), 0644); err != nil {
+		return "", fmt.Errorf("failed to write note: %w", err)
+	}
+
+	return notePath, nil
+}
+
+// writeScalar emits a YAML string using JSON quoting, which is valid YAML and
+// keeps values with colons or quotes intact.
+func writeScalar(b *strings.Builder, key, value string) {
+	if value == "" {
+		fmt.Fprintf(b, "%s:\n", key)
+		return
+	}
+	fmt.Fprintf(b, "%s: %s\n", key, quote(value))
+}
+
+func writeList(b *strings.Builder, key string, values []string) {
+	if len(values) == 0 {
+		fmt.Fprintf(b, "%s: []\n", key)
+		return
+	}
+	fmt.Fprintf(b, "%s:\n", key)
+	for _, value := range values {
+		fmt.Fprintf(b, "  - %s\n", quote(value))
+	}
+}
+
+func quote(value string) string {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return strconv.Quote(value)
+	}
+	return string(encoded)
+}
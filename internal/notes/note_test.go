@@ -0,0 +1,97 @@
+package notes
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRender(t *testing.T) {
+	meta := Metadata{
+		Customer:         "Acme",
+		Date:             "2026-02-04",
+		Attendees:        []string{"Jane Smith", "Tester"},
+		Tags:             []string{"meeting"},
+		Provider:         "gemini",
+		SourceTranscript: "2026-02-04-transcript.txt",
+		SummaryFile:      "/c/Acme/2026-02-04/summary.md",
+		ActionItems:      2,
+	}
+
+	note := Render(meta, "Customers/Acme", "*_TITLE_*\n\nBody\n")
+
+	expectedHeader := `---
+customer: "Acme"
+date: "2026-02-04"
+attendees:
+  - "Jane Smith"
+  - "Tester"
+tags:
+  - "meeting"
+provider: "gemini"
+source_transcript: "2026-02-04-transcript.txt"
+summary_file: "/c/Acme/2026-02-04/summary.md"
+action_items: 2
+---
+
+Customer: [[Customers/Acme]]
+
+*_TITLE_*`
+	if !strings.HasPrefix(note, expectedHeader) {
+		t.Fatalf("unexpected note:\n%s", note)
+	}
+	if !strings.HasSuffix(note, "Body\n") {
+		t.Errorf("expected summary body with single trailing newline, got %q", note[len(note)-10:])
+	}
+}
+
+func TestRenderEmptyValues(t *testing.T) {
+	note := Render(Metadata{Customer: `Acme "Corp"`}, "", "body")
+	if !strings.Contains(note, `customer: "Acme \"Corp\""`) {
+		t.Errorf("expected quoted customer, got:\n%s", note)
+	}
+	if !strings.Contains(note, "attendees: []\n") || !strings.Contains(note, "date:\n") {
+		t.Errorf("expected empty values rendered as empty YAML, got:\n%s", note)
+	}
+	if strings.Contains(note, "[[") {
+		t.Error("expected no wiki-link when customer page is empty")
+	}
+}
+
+func TestWrite(t *testing.T) {
+	vault := t.TempDir()
+	meta := Metadata{Customer: "Acme", Date: "2026-02-04"}
+
+	path, err := Write(Options{
+		VaultDir:         vault,
+		FilenameTemplate: "Meetings/{customer}/{date} {customer}",
+		CustomerPage:     "{customer}",
+	}, meta, "summary")
+	if err != nil {
+		t.Fatalf("write failed: %v", err)
+	}
+
+	expected := filepath.Join(vault, "Meetings", "Acme", "2026-02-04 Acme.md")
+	if path != expected {
+		t.Fatalf("expected %s, got %s", expected, path)
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read note: %v", err)
+	}
+	if !strings.Contains(string(content), "Customer: [[Acme]]") {
+		t.Errorf("expected customer wiki-link, got:\n%s", content)
+	}
+}
+
+func TestWriteRejectsMissingVaultAndEscapes(t *testing.T) {
+	if _, err := Write(Options{FilenameTemplate: "{customer}"}, Metadata{Customer: "Acme"}, "x"); err == nil {
+		t.Error("expected error when vault is not configured")
+	}
+
+	vault := t.TempDir()
+	if _, err := Write(Options{VaultDir: vault, FilenameTemplate: "../{customer}"}, Metadata{Customer: "Acme"}, "x"); err == nil {
+		t.Error("expected error when template escapes the vault")
+	}
+}
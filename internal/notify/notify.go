@@ -0,0 +1,48 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// ErrUnsupported is returned when no desktop notifier is available.
+var ErrUnsupported = errors.New("desktop notifications are not available on this system")
+
+// timeout bounds a single notification command.
+const timeout = 10 * time.Second
+
+// Send shows a desktop notification using osascript on macOS or notify-send
+// on Linux.
+func Send(title, message string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		script := fmt.Sprintf("display notification %s with title %s", appleScriptString(message), appleScriptString(title))
+		cmd = exec.CommandContext(ctx, "osascript", "-e", script)
+	case "linux", "freebsd", "openbsd":
+		if _, err := exec.LookPath("notify-send"); err != nil {
+			return ErrUnsupported
+		}
+		cmd = exec.CommandContext(ctx, "notify-send", "--app-name=meetsum", title, message)
+	default:
+		return ErrUnsupported
+	}
+
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("notification failed: %w: %s", err, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
+
+// appleScriptString quotes s as an AppleScript string literal.
+func appleScriptString(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
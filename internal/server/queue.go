@@ -0,0 +1,289 @@
+package server
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/bashfulrobot/meetsum/internal/app"
+	"github.com/charmbracelet/log"
+)
+
+// Status is the lifecycle state of a job.
+type Status string
+
+// Job states. Queued jobs still waiting when the server stops are canceled.
+const (
+	StatusQueued    Status = "queued"
+	StatusRunning   Status = "running"
+	StatusSucceeded Status = "succeeded"
+	StatusFailed    Status = "failed"
+	StatusCanceled  Status = "canceled"
+)
+
+// Queue errors.
+var (
+	ErrQueueFull   = errors.New("job queue is full; try again later")
+	ErrQueueClosed = errors.New("server is shutting down")
+	ErrBusy        = errors.New("a job for this meeting directory is already queued or running")
+)
+
+// Job is a summary run submitted over the API.
+type Job struct {
+	ID             string     `json:"id"`
+	Status         Status     `json:"status"`
+	MeetingDir     string     `json:"meeting_dir"`
+	MeetingType    string     `json:"meeting_type,omitempty"`
+	TranscriptFile string     `json:"transcript_file,omitempty"`
+	SubmittedAt    time.Time  `json:"submitted_at"`
+	StartedAt      *time.Time `json:"started_at,omitempty"`
+	FinishedAt     *time.Time `json:"finished_at,omitempty"`
+	Error          string     `json:"error,omitempty"`
+	Result         *JobResult `json:"result,omitempty"`
+}
+
+// Done reports whether the job has stopped running.
+func (j Job) Done() bool {
+	return j.Status == StatusSucceeded || j.Status == StatusFailed || j.Status == StatusCanceled
+}
+
+// JobResult lists the files a finished job wrote and any non-fatal warnings.
+type JobResult struct {
+	SummaryPath       string   `json:"summary_path"`
+	SlackSummaryPath  string   `json:"slack_summary_path,omitempty"`
+	RenamedTranscript string   `json:"renamed_transcript,omitempty"`
+	EmailPath         string   `json:"email_path,omitempty"`
+	NotePath          string   `json:"note_path,omitempty"`
+	SlackPermalink    string   `json:"slack_permalink,omitempty"`
+	RecordingURL      string   `json:"recording_url,omitempty"`
+	GitCommit         string   `json:"git_commit,omitempty"`
+	LintIssues        []string `json:"lint_issues,omitempty"`
+	Warnings          []string `json:"warnings,omitempty"`
+}
+
+// RunFunc performs the work for one job.
+type RunFunc func() (app.RunResult, error)
+
+type task struct {
+	id  string
+	run RunFunc
+}
+
+// Queue runs submitted jobs on a fixed number of workers and keeps the most
+// recent finished jobs for polling.
+type Queue struct {
+	mu      sync.Mutex
+	jobs    map[string]*Job
+	order   []string
+	tasks   chan task
+	history int
+	closed  bool
+	wg      sync.WaitGroup
+	logger  *log.Logger
+}
+
+// NewQueue starts workers that run jobs concurrently. At most size jobs wait
+// in the queue, and the newest history finished jobs are kept.
+func NewQueue(workers, size, history int, logger *log.Logger) *Queue {
+	if workers < 1 {
+		workers = 1
+	}
+	if size < 1 {
+		size = 1
+	}
+	if history < 1 {
+		history = 1
+	}
+
+	q := &Queue{
+		jobs:    make(map[string]*Job),
+		tasks:   make(chan task, size),
+		history: history,
+		logger:  logger,
+	}
+	for range workers {
+		q.wg.Add(1)
+		go q.work()
+	}
+	return q
+}
+
+// Submit queues a job and returns its initial state. Returns ErrBusy when the
+// meeting directory already has an unfinished job and ErrQueueFull when no
+// slot is free.
+func (q *Queue) Submit(job Job, run RunFunc) (Job, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return Job{}, ErrQueueClosed
+	}
+	for _, existing := range q.jobs {
+		if existing.MeetingDir == job.MeetingDir && !existing.Done() {
+			return Job{}, ErrBusy
+		}
+	}
+
+	id, err := newJobID()
+	if err != nil {
+		return Job{}, err
+	}
+	job.ID = id
+	job.Status = StatusQueued
+	job.SubmittedAt = time.Now().UTC()
+
+	select {
+	case q.tasks <- task{id: id, run: run}:
+	default:
+		return Job{}, ErrQueueFull
+	}
+
+	q.jobs[id] = &job
+	q.order = append(q.order, id)
+	return job, nil
+}
+
+// Get returns a snapshot of a job.
+func (q *Queue) Get(id string) (Job, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	job, ok := q.jobs[id]
+	if !ok {
+		return Job{}, false
+	}
+	return *job, true
+}
+
+// List returns up to limit jobs, newest first. A limit below 1 returns all.
+func (q *Queue) List(limit int) []Job {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	jobs := make([]Job, 0, len(q.order))
+	for i := len(q.order) - 1; i >= 0; i-- {
+		if limit > 0 && len(jobs) == limit {
+			break
+		}
+		jobs = append(jobs, *q.jobs[q.order[i]])
+	}
+	return jobs
+}
+
+// Close stops accepting jobs, cancels queued ones and waits for running jobs.
+func (q *Queue) Close() {
+	q.mu.Lock()
+	if q.closed {
+		q.mu.Unlock()
+		return
+	}
+	q.closed = true
+	close(q.tasks)
+	q.mu.Unlock()
+
+	q.wg.Wait()
+}
+
+func (q *Queue) work() {
+	defer q.wg.Done()
+
+	for t := range q.tasks {
+		if !q.start(t.id) {
+			continue
+		}
+
+		result, err := t.run()
+		q.finish(t.id, result, err)
+	}
+}
+
+// start marks a job running, or canceled when the queue has been closed.
+func (q *Queue) start(id string) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	now := time.Now().UTC()
+	job := q.jobs[id]
+	if q.closed {
+		job.Status = StatusCanceled
+		job.Error = ErrQueueClosed.Error()
+		job.FinishedAt = &now
+		return false
+	}
+	job.Status = StatusRunning
+	job.StartedAt = &now
+	return true
+}
+
+func (q *Queue) finish(id string, result app.RunResult, runErr error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	now := time.Now().UTC()
+	job := q.jobs[id]
+	job.FinishedAt = &now
+	if runErr != nil {
+		job.Status = StatusFailed
+		job.Error = runErr.Error()
+	} else {
+		job.Status = StatusSucceeded
+		job.Result = newJobResult(result)
+	}
+
+	if q.logger != nil {
+		q.logger.Info("job finished", "id", id, "status", job.Status, "meeting_dir", job.MeetingDir)
+	}
+	q.prune()
+}
+
+// prune drops the oldest finished jobs beyond the history limit.
+func (q *Queue) prune() {
+	finished := 0
+	for _, id := range q.order {
+		if q.jobs[id].Done() {
+			finished++
+		}
+	}
+
+	kept := q.order[:0]
+	for _, id := range q.order {
+		if finished > q.history && q.jobs[id].Done() {
+			delete(q.jobs, id)
+			finished--
+			continue
+		}
+		kept = append(kept, id)
+	}
+	q.order = kept
+}
+
+func newJobID() (string, error) {
+	buf := make([]byte, 8)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate job id: %w", err)
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// newJobResult converts a run result, collecting warnings the CLI would print.
+func newJobResult(result app.RunResult) *JobResult {
+	jobResult := &JobResult{
+		SummaryPath:       result.OutputPath,
+		SlackSummaryPath:  result.SlackOutputPath,
+		RenamedTranscript: result.RenamedTranscript,
+		EmailPath:         result.EmailOutputPath,
+		NotePath:          result.NoteOutputPath,
+		SlackPermalink:    result.SlackPermalink,
+		RecordingURL:      result.RecordingURL,
+		GitCommit:         result.GitCommit,
+	}
+	for _, issue := range result.LintIssues {
+		jobResult.LintIssues = append(jobResult.LintIssues, issue.String())
+	}
+
+	jobResult.Warnings = result.Warnings()
+	return jobResult
+}
@@ -0,0 +1,245 @@
+package server
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/bashfulrobot/meetsum/internal/app"
+	"github.com/charmbracelet/log"
+)
+
+// maxRequestBody bounds job submission payloads.
+const maxRequestBody = 1 << 20
+
+// defaultListLimit is the number of jobs GET /v1/jobs returns without ?limit.
+const defaultListLimit = 20
+
+// SubmitRequest is the body of POST /v1/jobs.
+type SubmitRequest struct {
+	MeetingDir   string `json:"meeting_dir"`
+	UserName     string `json:"user_name,omitempty"`
+	MeetingType  string `json:"meeting_type,omitempty"`
+	RecordingURL string `json:"recording_url,omitempty"`
+}
+
+// Options configures the HTTP API.
+type Options struct {
+	// Token, when set, must be sent as "Authorization: Bearer <token>".
+	// Without a token only requests addressed to a loopback host are served.
+	Token string
+	// UserName is used for submissions that do not name a user.
+	UserName string
+}
+
+// Server exposes app.Service over HTTP.
+type Server struct {
+	service *app.Service
+	queue   *Queue
+	options Options
+	logger  *log.Logger
+}
+
+// New creates an API server that prepares runs with service and executes them
+// on queue.
+func New(service *app.Service, queue *Queue, options Options, logger *log.Logger) *Server {
+	return &Server{service: service, queue: queue, options: options, logger: logger}
+}
+
+// Handler returns the API routes. Everything except /healthz requires the
+// bearer token when one is configured, or a loopback Host header when not.
+func (s *Server) Handler() http.Handler {
+	api := http.NewServeMux()
+	api.HandleFunc("POST /v1/jobs", s.handleSubmit)
+	api.HandleFunc("GET /v1/jobs", s.handleList)
+	api.HandleFunc("GET /v1/jobs/{id}", s.handleGet)
+	api.HandleFunc("GET /v1/jobs/{id}/summary", s.handleFile(func(r *JobResult) string { return r.SummaryPath }))
+	api.HandleFunc("GET /v1/jobs/{id}/slack-summary", s.handleFile(func(r *JobResult) string { return r.SlackSummaryPath }))
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /healthz", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+	})
+	mux.Handle("/", s.authenticate(api))
+	return mux
+}
+
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	if s.options.Token == "" {
+		// A web page can reach a loopback server through a DNS name it
+		// controls; the Host header still carries that name
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !isLoopbackHost(r.Host) {
+				writeError(w, http.StatusForbidden, "without a token, requests must address the server as localhost or a loopback IP")
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+	expected := []byte("Bearer " + s.options.Token)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), expected) != 1 {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="meetsum"`)
+			writeError(w, http.StatusUnauthorized, "missing or invalid bearer token")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// isLoopbackHost reports whether a Host header names the local machine.
+func isLoopbackHost(host string) bool {
+	if name, _, err := net.SplitHostPort(host); err == nil {
+		host = name
+	}
+	host = strings.TrimSuffix(strings.TrimPrefix(host, "["), "]")
+	if strings.EqualFold(host, "localhost") {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+func (s *Server) handleSubmit(w http.ResponseWriter, r *http.Request) {
+	// Browsers send form content types cross-origin without a preflight
+	if mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type")); err != nil || mediaType != "application/json" {
+		writeError(w, http.StatusUnsupportedMediaType, "Content-Type must be application/json")
+		return
+	}
+
+	var request SubmitRequest
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBody))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&request); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %v", err))
+		return
+	}
+
+	meetingDir := strings.TrimSpace(request.MeetingDir)
+	if !filepath.IsAbs(meetingDir) {
+		writeError(w, http.StatusBadRequest, "meeting_dir must be an absolute path")
+		return
+	}
+	if info, err := os.Stat(meetingDir); err != nil || !info.IsDir() {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("meeting directory %s does not exist", meetingDir))
+		return
+	}
+
+	userName := strings.TrimSpace(request.UserName)
+	if userName == "" {
+		userName = s.options.UserName
+	}
+
+	session, err := s.service.Prepare(app.RunRequest{
+		UserName:     userName,
+		MeetingDir:   filepath.Clean(meetingDir),
+		MeetingType:  request.MeetingType,
+		RecordingURL: request.RecordingURL,
+	})
+	if err != nil {
+		writeError(w, http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+	// The meeting's .meetsum.yaml may select another AI command
+	if _, err := session.Preflight(); err != nil {
+		writeError(w, http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+	preparation := session.Preparation()
+
+	job, err := s.queue.Submit(Job{
+		MeetingDir:     preparation.MeetingDir,
+		MeetingType:    preparation.MeetingType,
+		TranscriptFile: preparation.TranscriptFile,
+	}, session.Run)
+	switch {
+	case errors.Is(err, ErrBusy):
+		writeError(w, http.StatusConflict, err.Error())
+		return
+	case errors.Is(err, ErrQueueFull), errors.Is(err, ErrQueueClosed):
+		w.Header().Set("Retry-After", "30")
+		writeError(w, http.StatusServiceUnavailable, err.Error())
+		return
+	case err != nil:
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if s.logger != nil {
+		s.logger.Info("job queued", "id", job.ID, "meeting_dir", job.MeetingDir)
+	}
+	w.Header().Set("Location", "/v1/jobs/"+job.ID)
+	writeJSON(w, http.StatusAccepted, job)
+}
+
+func (s *Server) handleList(w http.ResponseWriter, r *http.Request) {
+	limit := defaultListLimit
+	if raw := r.URL.Query().Get("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 1 {
+			writeError(w, http.StatusBadRequest, "limit must be a positive integer")
+			return
+		}
+		limit = parsed
+	}
+	writeJSON(w, http.StatusOK, map[string][]Job{"jobs": s.queue.List(limit)})
+}
+
+func (s *Server) handleGet(w http.ResponseWriter, r *http.Request) {
+	job, ok := s.queue.Get(r.PathValue("id"))
+	if !ok {
+		writeError(w, http.StatusNotFound, "job not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, job)
+}
+
+// handleFile serves one of a finished job's output files as markdown.
+func (s *Server) handleFile(path func(*JobResult) string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		job, ok := s.queue.Get(r.PathValue("id"))
+		if !ok {
+			writeError(w, http.StatusNotFound, "job not found")
+			return
+		}
+		if job.Status != StatusSucceeded {
+			writeError(w, http.StatusConflict, fmt.Sprintf("job is %s", job.Status))
+			return
+		}
+
+		filePath := path(job.Result)
+		if filePath == "" {
+			writeError(w, http.StatusNotFound, "the job did not produce this file")
+			return
+		}
+		content, err := os.ReadFile(filePath)
+		if err != nil {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("failed to read %s: %v", filepath.Base(filePath), err))
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/markdown; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(content)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, value any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	_ = encoder.Encode(value)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"error": message})
+}
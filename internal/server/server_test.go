@@ -0,0 +1,318 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bashfulrobot/meetsum/config"
+	"github.com/bashfulrobot/meetsum/internal/app"
+)
+
+const fakeSummary = `*_2026-02-04 ACME CADENCE CALL SUMMARY_*
+
+*HIGHLIGHTS*
+
+- Key insight from the meeting.
+
+*ACTION ITEMS*
+
+- Tester: Complete the analysis.
+`
+
+func TestServerRunsJobs(t *testing.T) {
+	commandDir := t.TempDir()
+	script := "#!/usr/bin/env bash\ncat >/dev/null\ncat <<'OUT'\n" + fakeSummary + "OUT\n"
+	if err := os.WriteFile(filepath.Join(commandDir, "fake-ai-serve"), []byte(script), 0755); err != nil {
+		t.Fatalf("failed to write fake AI command: %v", err)
+	}
+	t.Setenv("PATH", commandDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	meetingDir := createMeetingDir(t)
+	queue := NewQueue(1, 4, 10, nil)
+	defer queue.Close()
+	srv := httptest.NewServer(New(app.NewService(newTestConfig(t, "fake-ai-serve"), nil), queue, Options{Token: "s3cret", UserName: "Tester"}, nil).Handler())
+	defer srv.Close()
+
+	if resp := request(t, srv, http.MethodGet, "/healthz", "", ""); resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected healthz without token, got %d", resp.StatusCode)
+	}
+	if resp := request(t, srv, http.MethodGet, "/v1/jobs", "", "wrong"); resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected 401 with a wrong token, got %d", resp.StatusCode)
+	}
+
+	resp := request(t, srv, http.MethodPost, "/v1/jobs", `{"meeting_dir":"relative/dir"}`, "s3cret")
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected 400 for a relative dir, got %d", resp.StatusCode)
+	}
+
+	resp = request(t, srv, http.MethodPost, "/v1/jobs", `{"meeting_dir":"`+meetingDir+`"}`, "s3cret")
+	if resp.StatusCode != http.StatusAccepted {
+		t.Fatalf("expected 202, got %d: %s", resp.StatusCode, readBody(t, resp))
+	}
+	var job Job
+	decode(t, resp, &job)
+	if job.ID == "" || job.TranscriptFile != "transcript.txt" || job.MeetingType != config.DefaultMeetingType {
+		t.Fatalf("unexpected job %+v", job)
+	}
+
+	job = waitForJob(t, srv, job.ID)
+	if job.Status != StatusSucceeded {
+		t.Fatalf("expected job to succeed, got %s: %s", job.Status, job.Error)
+	}
+	if job.Result == nil || job.Result.RenamedTranscript != "2026-02-04-transcript.txt" {
+		t.Fatalf("unexpected result %+v", job.Result)
+	}
+
+	summary := readBody(t, request(t, srv, http.MethodGet, "/v1/jobs/"+job.ID+"/summary", "", "s3cret"))
+	if !strings.Contains(summary, "*HIGHLIGHTS*") {
+		t.Errorf("expected summary content, got %q", summary)
+	}
+	slackSummary := readBody(t, request(t, srv, http.MethodGet, "/v1/jobs/"+job.ID+"/slack-summary", "", "s3cret"))
+	if !strings.Contains(slackSummary, "*FULL MEETING SUMMARY*") {
+		t.Errorf("expected slack summary content, got %q", slackSummary)
+	}
+
+	var list struct {
+		Jobs []Job `json:"jobs"`
+	}
+	decode(t, request(t, srv, http.MethodGet, "/v1/jobs?limit=5", "", "s3cret"), &list)
+	if len(list.Jobs) != 1 || list.Jobs[0].ID != job.ID {
+		t.Errorf("expected the finished job in the list, got %+v", list.Jobs)
+	}
+
+	if resp := request(t, srv, http.MethodGet, "/v1/jobs/missing", "", "s3cret"); resp.StatusCode != http.StatusNotFound {
+		t.Errorf("expected 404 for an unknown job, got %d", resp.StatusCode)
+	}
+}
+
+func TestServerRejectsUnpreparedDirectory(t *testing.T) {
+	queue := NewQueue(1, 1, 1, nil)
+	defer queue.Close()
+	srv := httptest.NewServer(New(app.NewService(newTestConfig(t, "echo"), nil), queue, Options{UserName: "Tester"}, nil).Handler())
+	defer srv.Close()
+
+	resp := request(t, srv, http.MethodPost, "/v1/jobs", `{"meeting_dir":"`+t.TempDir()+`"}`, "")
+	if resp.StatusCode != http.StatusUnprocessableEntity {
+		t.Fatalf("expected 422 for a directory without a transcript, got %d", resp.StatusCode)
+	}
+	if body := readBody(t, resp); !strings.Contains(body, "error") {
+		t.Errorf("expected an error body, got %q", body)
+	}
+}
+
+func TestServerChecksEachMeetingsCommand(t *testing.T) {
+	commandDir := t.TempDir()
+	script := "#!/usr/bin/env bash\ncat >/dev/null\ncat <<'OUT'\n" + fakeSummary + "OUT\n"
+	if err := os.WriteFile(filepath.Join(commandDir, "fake-ai-override"), []byte(script), 0755); err != nil {
+		t.Fatalf("failed to write fake AI command: %v", err)
+	}
+	t.Setenv("PATH", commandDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	queue := NewQueue(1, 4, 10, nil)
+	defer queue.Close()
+	srv := httptest.NewServer(New(app.NewService(newTestConfig(t, "no-such-ai-command"), nil), queue, Options{UserName: "Tester"}, nil).Handler())
+	defer srv.Close()
+
+	if resp := request(t, srv, http.MethodPost, "/v1/jobs", `{"meeting_dir":"`+createMeetingDir(t)+`"}`, ""); resp.StatusCode != http.StatusUnprocessableEntity {
+		t.Fatalf("expected 422 for a missing AI command, got %d", resp.StatusCode)
+	}
+
+	meetingDir := createMeetingDir(t)
+	if err := os.WriteFile(filepath.Join(meetingDir, config.DirConfigFile), []byte("ai:\n  command: fake-ai-override\n"), 0644); err != nil {
+		t.Fatalf("failed to write override: %v", err)
+	}
+	resp := request(t, srv, http.MethodPost, "/v1/jobs", `{"meeting_dir":"`+meetingDir+`"}`, "")
+	if resp.StatusCode != http.StatusAccepted {
+		t.Fatalf("expected 202 with the override's command, got %d: %s", resp.StatusCode, readBody(t, resp))
+	}
+	var job Job
+	decode(t, resp, &job)
+	if job = waitForJob(t, srv, job.ID); job.Status != StatusSucceeded {
+		t.Fatalf("expected job to succeed, got %s: %s", job.Status, job.Error)
+	}
+}
+
+func TestServerRejectsCrossSiteRequests(t *testing.T) {
+	queue := NewQueue(1, 1, 1, nil)
+	defer queue.Close()
+	srv := httptest.NewServer(New(app.NewService(newTestConfig(t, "echo"), nil), queue, Options{UserName: "Tester"}, nil).Handler())
+	defer srv.Close()
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodPost, srv.URL+"/v1/jobs", strings.NewReader(`{"meeting_dir":"/tmp"}`))
+	if err != nil {
+		t.Fatalf("failed to build request: %v", err)
+	}
+	req.Header.Set("Content-Type", "text/plain")
+	resp, err := srv.Client().Do(req)
+	if err != nil {
+		t.Fatalf("POST failed: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnsupportedMediaType {
+		t.Fatalf("expected 415 for a non-JSON body, got %d", resp.StatusCode)
+	}
+
+	for host, want := range map[string]int{
+		"localhost:7733":        http.StatusOK,
+		"127.0.0.1:7733":        http.StatusOK,
+		"[::1]:7733":            http.StatusOK,
+		"attacker.example":      http.StatusForbidden,
+		"attacker.example:7733": http.StatusForbidden,
+	} {
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, srv.URL+"/v1/jobs", nil)
+		if err != nil {
+			t.Fatalf("failed to build request: %v", err)
+		}
+		req.Host = host
+		resp, err := srv.Client().Do(req)
+		if err != nil {
+			t.Fatalf("GET with Host %s failed: %v", host, err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != want {
+			t.Errorf("Host %s: expected %d, got %d", host, want, resp.StatusCode)
+		}
+	}
+}
+
+func TestQueueBoundsAndHistory(t *testing.T) {
+	release := make(chan struct{})
+	blocking := func() (app.RunResult, error) {
+		<-release
+		return app.RunResult{OutputPath: "summary.md"}, nil
+	}
+
+	queue := NewQueue(1, 1, 2, nil)
+	first, err := queue.Submit(Job{MeetingDir: "/a"}, blocking)
+	if err != nil {
+		t.Fatalf("submit failed: %v", err)
+	}
+	waitFor(t, func() bool { job, _ := queue.Get(first.ID); return job.Status == StatusRunning })
+
+	if _, err := queue.Submit(Job{MeetingDir: "/a"}, blocking); !errors.Is(err, ErrBusy) {
+		t.Errorf("expected ErrBusy for the same directory, got %v", err)
+	}
+	if _, err := queue.Submit(Job{MeetingDir: "/b"}, blocking); err != nil {
+		t.Fatalf("expected the queue slot to accept a job: %v", err)
+	}
+	if _, err := queue.Submit(Job{MeetingDir: "/c"}, blocking); !errors.Is(err, ErrQueueFull) {
+		t.Errorf("expected ErrQueueFull, got %v", err)
+	}
+
+	close(release)
+	waitFor(t, func() bool { return len(queue.List(0)) == 2 && queue.List(0)[0].Done() })
+
+	failing := func() (app.RunResult, error) { return app.RunResult{}, errors.New("boom") }
+	third, err := queue.Submit(Job{MeetingDir: "/c"}, failing)
+	if err != nil {
+		t.Fatalf("submit failed: %v", err)
+	}
+	waitFor(t, func() bool { job, _ := queue.Get(third.ID); return job.Done() })
+
+	jobs := queue.List(0)
+	if len(jobs) != 2 || jobs[0].ID != third.ID || jobs[0].Status != StatusFailed || jobs[0].Error != "boom" {
+		t.Fatalf("expected history trimmed to the newest two jobs, got %+v", jobs)
+	}
+	if _, ok := queue.Get(first.ID); ok {
+		t.Error("expected the oldest job to be pruned")
+	}
+
+	queue.Close()
+	if _, err := queue.Submit(Job{MeetingDir: "/d"}, failing); !errors.Is(err, ErrQueueClosed) {
+		t.Errorf("expected ErrQueueClosed, got %v", err)
+	}
+}
+
+func request(t *testing.T, srv *httptest.Server, method, path, body, token string) *http.Response {
+	t.Helper()
+	req, err := http.NewRequestWithContext(t.Context(), method, srv.URL+path, strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("failed to build request: %v", err)
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := srv.Client().Do(req)
+	if err != nil {
+		t.Fatalf("%s %s failed: %v", method, path, err)
+	}
+	t.Cleanup(func() { resp.Body.Close() })
+	return resp
+}
+
+func readBody(t *testing.T, resp *http.Response) string {
+	t.Helper()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("failed to read body: %v", err)
+	}
+	return string(data)
+}
+
+func decode(t *testing.T, resp *http.Response, value any) {
+	t.Helper()
+	if err := json.NewDecoder(resp.Body).Decode(value); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+}
+
+func waitForJob(t *testing.T, srv *httptest.Server, id string) Job {
+	t.Helper()
+	var job Job
+	waitFor(t, func() bool {
+		decode(t, request(t, srv, http.MethodGet, "/v1/jobs/"+id, "", "s3cret"), &job)
+		return job.Done()
+	})
+	return job
+}
+
+func waitFor(t *testing.T, condition func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(10 * time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for condition")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func newTestConfig(t *testing.T, command string) *config.Config {
+	t.Helper()
+
+	automationDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(automationDir, "instructions.md"), []byte("Meeting instructions"), 0644); err != nil {
+		t.Fatalf("failed to write instructions file: %v", err)
+	}
+
+	cfg := &config.Config{}
+	cfg.Paths.AutomationDir = automationDir
+	cfg.Paths.InstructionsFile = "instructions.md"
+	cfg.Files.PovInput = "pov-input.md"
+	cfg.AI.Command = command
+	return cfg
+}
+
+func createMeetingDir(t *testing.T) string {
+	t.Helper()
+
+	meetingDir := filepath.Join(t.TempDir(), "Customers", "Acme", "2026-02-04")
+	if err := os.MkdirAll(meetingDir, 0755); err != nil {
+		t.Fatalf("failed to create meeting dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(meetingDir, "transcript.txt"), []byte("transcript content"), 0644); err != nil {
+		t.Fatalf("failed to write transcript: %v", err)
+	}
+	return meetingDir
+}
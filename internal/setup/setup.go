@@ -0,0 +1,332 @@
+// Package setup holds the non-interactive parts of `meetsum init`: AI CLI
+// detection, path checks, instructions scaffolding and rendering settings.yaml.
+package setup
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/bashfulrobot/meetsum/samples"
+	"go.yaml.in/yaml/v3"
+)
+
+// Provider is an AI CLI meetsum knows how to drive. Args make the CLI read
+// the prompt from stdin and print the answer to stdout.
+type Provider struct {
+	Name        string
+	Command     string
+	Args        []string
+	Description string
+}
+
+// Providers are the AI CLIs offered by the wizard, in order of preference.
+var Providers = []Provider{
+	{Name: "gemini", Command: "gemini", Description: "Google Gemini CLI"},
+	{Name: "claude", Command: "claude", Args: []string{"-p"}, Description: "Anthropic Claude Code in print mode"},
+	{Name: "codex", Command: "codex", Args: []string{"exec", "-"}, Description: "OpenAI Codex CLI, prompt from stdin"},
+	{Name: "ollama", Command: "ollama", Args: []string{"run", "llama3.2"}, Description: "Local model through Ollama (edit the model name)"},
+}
+
+// DetectProviders returns the known AI CLIs found in PATH.
+func DetectProviders() []Provider {
+	var found []Provider
+	for _, provider := range Providers {
+		if _, err := exec.LookPath(provider.Command); err == nil {
+			found = append(found, provider)
+		}
+	}
+	return found
+}
+
+// Settings are the answers collected by the wizard.
+type Settings struct {
+	UserName         string
+	RootDir          string
+	AutomationDir    string
+	InstructionsFile string
+	AICommand        string
+	AIArgs           []string
+	WritingStyle     string
+	Humanizer        string
+	LogLevel         string
+	LogOutput        string
+	LogFile          string
+}
+
+// ExpandHome replaces a leading ~ with the user's home directory.
+func ExpandHome(path string) string {
+	path = strings.TrimSpace(path)
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, strings.TrimPrefix(path, "~"))
+}
+
+// CheckExistingDir requires path to be an existing directory.
+func CheckExistingDir(path string) error {
+	if strings.TrimSpace(path) == "" {
+		return fmt.Errorf("a directory is required")
+	}
+	info, err := os.Stat(ExpandHome(path))
+	if os.IsNotExist(err) {
+		return fmt.Errorf("%s does not exist", path)
+	}
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("%s is not a directory", path)
+	}
+	return nil
+}
+
+// CheckCreatableDir accepts an existing directory, or a missing one whose
+// closest existing parent is a directory, so init can create it.
+func CheckCreatableDir(path string) error {
+	if strings.TrimSpace(path) == "" {
+		return fmt.Errorf("a directory is required")
+	}
+	current := ExpandHome(path)
+	for {
+		info, err := os.Stat(current)
+		if err == nil {
+			if !info.IsDir() {
+				return fmt.Errorf("%s is a file, not a directory", current)
+			}
+			return nil
+		}
+		if !os.IsNotExist(err) {
+			return err
+		}
+		parent := filepath.Dir(current)
+		if parent == current {
+			return fmt.Errorf("%s cannot be created", path)
+		}
+		current = parent
+	}
+}
+
+// CheckOptionalFile accepts an empty path or an existing file.
+func CheckOptionalFile(path string) error {
+	if strings.TrimSpace(path) == "" {
+		return nil
+	}
+	info, err := os.Stat(ExpandHome(path))
+	if os.IsNotExist(err) {
+		return fmt.Errorf("%s does not exist; leave it empty to skip", path)
+	}
+	if err != nil {
+		return err
+	}
+	if info.IsDir() {
+		return fmt.Errorf("%s is a directory, not a file", path)
+	}
+	return nil
+}
+
+// CheckFileName requires a plain file name without directories.
+func CheckFileName(name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return fmt.Errorf("a file name is required")
+	}
+	if strings.ContainsAny(name, `/\`) {
+		return fmt.Errorf("use a file name, not a path")
+	}
+	return nil
+}
+
+// ScaffoldInstructions writes the bundled sample instructions to
+// automationDir/file unless a file is already there. Returns the path and
+// whether it was created.
+func ScaffoldInstructions(automationDir, file string) (string, bool, error) {
+	path := filepath.Join(ExpandHome(automationDir), file)
+	if _, err := os.Stat(path); err == nil {
+		return path, false, nil
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return path, false, fmt.Errorf("failed to create %s: %w", filepath.Dir(path), err)
+	}
+	if err := os.WriteFile(path, samples.Instructions, 0644); err != nil {
+		return path, false, fmt.Errorf("failed to write instructions: %w", err)
+	}
+	return path, true, nil
+}
+
+// WriteConfig writes content to path. An existing file is kept as path.bak.
+// Returns the backup path, or empty when there was nothing to back up.
+func WriteConfig(path, content string) (string, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return "", fmt.Errorf("failed to create %s: %w", filepath.Dir(path), err)
+	}
+
+	backup := ""
+	if existing, err := os.ReadFile(path); err == nil {
+		backup = path + ".bak"
+		if err := os.WriteFile(backup, existing, 0600); err != nil {
+			return "", fmt.Errorf("failed to back up %s: %w", path, err)
+		}
+	}
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		return backup, fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return backup, nil
+}
+
+// Render returns settings.yaml for s, with the same section banners and
+// comments as settings.sample.yaml. Sections the wizard does not ask about
+// keep their defaults and are left out.
+func Render(s Settings) string {
+	var b strings.Builder
+	b.WriteString(`# meetsum - Meeting Summary Generator Configuration
+#
+# Written by "meetsum init". Every option, including the ones left at their
+# defaults here, is documented in samples/settings.sample.yaml.
+
+`)
+
+	section(&b, "USER CONFIGURATION")
+	b.WriteString("user:\n")
+	b.WriteString("  # Your name, used for the first-person perspective of summaries\n")
+	fmt.Fprintf(&b, "  name: %s\n\n", quote(s.UserName))
+
+	section(&b, "PATH CONFIGURATION")
+	b.WriteString("paths:\n")
+	b.WriteString("  # Root directory for the file browser\n")
+	b.WriteString("  # Example structure: /path/to/Customers/CustomerName/2024-01-15/\n")
+	fmt.Fprintf(&b, "  file_browser_root_dir: %s\n\n", quote(s.RootDir))
+	b.WriteString("  # Directory containing the LLM instructions markdown file\n")
+	fmt.Fprintf(&b, "  automation_dir: %s\n\n", quote(s.AutomationDir))
+	b.WriteString("  # Name of the LLM instructions file inside automation_dir\n")
+	fmt.Fprintf(&b, "  instructions_file: %s\n\n", quote(s.InstructionsFile))
+
+	section(&b, "AI CONFIGURATION")
+	b.WriteString("ai:\n")
+	b.WriteString("  # AI CLI in your PATH; it receives the prompt on stdin\n")
+	fmt.Fprintf(&b, "  command: %s\n\n", quote(s.AICommand))
+	b.WriteString("  # Arguments passed to the command, one token per item (no shell parsing)\n")
+	if len(s.AIArgs) == 0 {
+		b.WriteString("  args: []\n\n")
+	} else {
+		b.WriteString("  args:\n")
+		for _, arg := range s.AIArgs {
+			fmt.Fprintf(&b, "    - %s\n", quote(arg))
+		}
+		b.WriteString("\n")
+	}
+
+	section(&b, "WRITING SKILLS CONFIGURATION")
+	b.WriteString("# The first skill file that exists is added to the prompt:\n")
+	b.WriteString("# writing_style, then humanizer, then none. Paths support ~.\n")
+	b.WriteString("skills:\n")
+	b.WriteString("  # Custom skill trained on your writing style (highest priority)\n")
+	fmt.Fprintf(&b, "  writing_style: %s\n\n", quote(s.WritingStyle))
+	b.WriteString("  # Generic skill that removes common AI writing patterns (fallback)\n")
+	fmt.Fprintf(&b, "  humanizer: %s\n\n", quote(s.Humanizer))
+
+	section(&b, "LOGGING CONFIGURATION")
+	b.WriteString("logging:\n")
+	b.WriteString("  # Log level: debug, info, warn, error\n")
+	fmt.Fprintf(&b, "  level: %s\n\n", quote(s.LogLevel))
+	b.WriteString("  # Log file path, used when output includes \"file\"\n")
+	fmt.Fprintf(&b, "  file: %s\n\n", quote(s.LogFile))
+	b.WriteString("  # Output destination: screen, file, both\n")
+	fmt.Fprintf(&b, "  output: %s\n", quote(s.LogOutput))
+
+	return b.String()
+}
+
+// Update applies s to an existing settings.yaml and returns the new content.
+// Only the settings the wizard asks about are changed; every other section,
+// unknown keys and comments are kept. Empty content renders a new file.
+func Update(existing []byte, s Settings) ([]byte, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(existing, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse existing settings: %w", err)
+	}
+	if len(doc.Content) == 0 {
+		return []byte(Render(s)), nil
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("existing settings are not a YAML mapping")
+	}
+
+	for _, setting := range []struct {
+		section, key, value string
+	}{
+		{"user", "name", s.UserName},
+		{"paths", "file_browser_root_dir", s.RootDir},
+		{"paths", "automation_dir", s.AutomationDir},
+		{"paths", "instructions_file", s.InstructionsFile},
+		{"ai", "command", s.AICommand},
+		{"skills", "writing_style", s.WritingStyle},
+		{"skills", "humanizer", s.Humanizer},
+		{"logging", "level", s.LogLevel},
+		{"logging", "file", s.LogFile},
+		{"logging", "output", s.LogOutput},
+	} {
+		node := mappingValue(mappingValue(root, setting.section, yaml.MappingNode), setting.key, yaml.ScalarNode)
+		node.Kind, node.Tag, node.Value, node.Style = yaml.ScalarNode, "!!str", setting.value, yaml.DoubleQuotedStyle
+		node.Content = nil
+	}
+
+	args := mappingValue(mappingValue(root, "ai", yaml.MappingNode), "args", yaml.SequenceNode)
+	args.Kind, args.Tag, args.Value, args.Content = yaml.SequenceNode, "!!seq", "", nil
+	for _, arg := range s.AIArgs {
+		args.Content = append(args.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: arg, Style: yaml.DoubleQuotedStyle})
+	}
+	if len(s.AIArgs) == 0 {
+		args.Style = yaml.FlowStyle
+	}
+
+	var b strings.Builder
+	encoder := yaml.NewEncoder(&b)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&doc); err != nil {
+		return nil, fmt.Errorf("failed to encode settings: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, fmt.Errorf("failed to encode settings: %w", err)
+	}
+	return []byte(b.String()), nil
+}
+
+// mappingValue returns the value node for key in mapping, matching keys
+// case-insensitively like viper, and appends an empty node of kind when the
+// key is missing.
+func mappingValue(mapping *yaml.Node, key string, kind yaml.Kind) *yaml.Node {
+	if mapping.Kind != yaml.MappingNode {
+		// A scalar or null section (e.g. "ai:" with no keys) becomes a mapping
+		mapping.Kind, mapping.Tag, mapping.Value, mapping.Style, mapping.Content = yaml.MappingNode, "!!map", "", 0, nil
+	}
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if strings.EqualFold(mapping.Content[i].Value, key) {
+			return mapping.Content[i+1]
+		}
+	}
+	value := &yaml.Node{Kind: kind}
+	mapping.Content = append(mapping.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}, value)
+	return value
+}
+
+func section(b *strings.Builder, title string) {
+	rule := "# " + strings.Repeat("=", 76) + "\n"
+	b.WriteString(rule)
+	b.WriteString("# " + title + "\n")
+	b.WriteString(rule)
+}
+
+// quote renders a YAML double-quoted scalar. Go and YAML escapes agree for
+// the characters a setting can reasonably contain.
+func quote(value string) string {
+	return strconv.Quote(value)
+}
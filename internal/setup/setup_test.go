@@ -0,0 +1,224 @@
+package setup
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/bashfulrobot/meetsum/config"
+	"github.com/bashfulrobot/meetsum/samples"
+	"github.com/spf13/viper"
+)
+
+func TestRenderRoundTrips(t *testing.T) {
+	settings := Settings{
+		UserName:         `Dana "DK" Kim`,
+		RootDir:          "~/Documents/Company/Customers",
+		AutomationDir:    "/srv/automation",
+		InstructionsFile: "Meeting-summary-llm-instructions.md",
+		AICommand:        "ollama",
+		AIArgs:           []string{"run", "llama3.2"},
+		WritingStyle:     "",
+		Humanizer:        "~/.claude/skills/humanizer/humanizer.md",
+		LogLevel:         "warn",
+		LogOutput:        "both",
+		LogFile:          "~/.config/meetsum/error.log",
+	}
+	content := Render(settings)
+	if !strings.Contains(content, "# AI CONFIGURATION") || !strings.Contains(content, "# Log level: debug, info, warn, error") {
+		t.Errorf("rendered config is missing its comments:\n%s", content)
+	}
+
+	v := viper.New()
+	v.SetConfigType("yaml")
+	if err := v.ReadConfig(strings.NewReader(content)); err != nil {
+		t.Fatalf("rendered config is not valid YAML: %v\n%s", err, content)
+	}
+	var cfg config.Config
+	if err := v.Unmarshal(&cfg); err != nil {
+		t.Fatalf("failed to unmarshal: %v", err)
+	}
+
+	if cfg.User.Name != settings.UserName || cfg.Paths.FileBrowserRootDir != settings.RootDir ||
+		cfg.Paths.AutomationDir != settings.AutomationDir || cfg.Paths.InstructionsFile != settings.InstructionsFile {
+		t.Errorf("paths did not round trip: %+v %+v", cfg.User, cfg.Paths)
+	}
+	if cfg.AI.Command != "ollama" || !reflect.DeepEqual(cfg.AI.Args, settings.AIArgs) {
+		t.Errorf("AI settings did not round trip: %+v", cfg.AI)
+	}
+	if cfg.Skills.WritingStyle != "" || cfg.Skills.Humanizer != settings.Humanizer {
+		t.Errorf("skills did not round trip: %+v", cfg.Skills)
+	}
+	if cfg.Logging.Level != "warn" || cfg.Logging.Output != "both" || cfg.Logging.File != settings.LogFile {
+		t.Errorf("logging did not round trip: %+v", cfg.Logging)
+	}
+
+	if !strings.Contains(Render(Settings{AICommand: "gemini"}), "args: []") {
+		t.Error("empty args should render as an empty list")
+	}
+}
+
+func TestUpdateKeepsOtherSettings(t *testing.T) {
+	existing := `# My meetsum settings
+user:
+  name: "Old Name"  # shown in summaries
+paths:
+  FILE_BROWSER_ROOT_DIR: "/old/root"
+  automation_dir: "/old/automation"
+ai:
+  command: "codex"
+  args: ["exec", "-"]
+slack:
+  enabled: true
+  channel: "#meetings"
+meetings:
+  types:
+    qbr:
+      prompt_extras: "Lead with the numbers."
+`
+	settings := Settings{
+		UserName:         "New Name",
+		RootDir:          "/new/root",
+		AutomationDir:    "/new/automation",
+		InstructionsFile: "instructions.md",
+		AICommand:        "claude",
+		AIArgs:           []string{"-p"},
+		LogLevel:         "warn",
+		LogOutput:        "screen",
+		LogFile:          "/tmp/error.log",
+	}
+
+	content, err := Update([]byte(existing), settings)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, kept := range []string{"# My meetsum settings", "# shown in summaries", "channel: \"#meetings\"", "prompt_extras: \"Lead with the numbers.\""} {
+		if !strings.Contains(string(content), kept) {
+			t.Errorf("updated settings lost %q:\n%s", kept, content)
+		}
+	}
+
+	v := viper.New()
+	v.SetConfigType("yaml")
+	if err := v.ReadConfig(bytes.NewReader(content)); err != nil {
+		t.Fatalf("updated config is not valid YAML: %v\n%s", err, content)
+	}
+	var cfg config.Config
+	if err := v.Unmarshal(&cfg); err != nil {
+		t.Fatalf("failed to unmarshal: %v", err)
+	}
+	if cfg.User.Name != "New Name" || cfg.Paths.FileBrowserRootDir != "/new/root" || cfg.Paths.InstructionsFile != "instructions.md" {
+		t.Errorf("wizard settings were not applied: %+v %+v", cfg.User, cfg.Paths)
+	}
+	if cfg.AI.Command != "claude" || !reflect.DeepEqual(cfg.AI.Args, []string{"-p"}) || cfg.Logging.Level != "warn" {
+		t.Errorf("wizard settings were not applied: %+v %+v", cfg.AI, cfg.Logging)
+	}
+	if !cfg.Slack.Enabled || cfg.GetPromptExtrasForType("qbr") != "Lead with the numbers." {
+		t.Errorf("other sections were not kept: %+v %+v", cfg.Slack, cfg.Meetings.Types)
+	}
+	if strings.Count(strings.ToLower(string(content)), "file_browser_root_dir") != 1 {
+		t.Errorf("keys must be matched case-insensitively:\n%s", content)
+	}
+
+	if content, err := Update(nil, settings); err != nil || string(content) != Render(settings) {
+		t.Errorf("a missing file should render a new one, got %v", err)
+	}
+	if _, err := Update([]byte("- just\n- a list\n"), settings); err == nil {
+		t.Error("a non-mapping file must not be replaced")
+	}
+}
+
+func TestScaffoldInstructions(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "automation")
+
+	path, created, err := ScaffoldInstructions(dir, "instructions.md")
+	if err != nil || !created {
+		t.Fatalf("expected the sample to be created, got %v %v", created, err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil || !bytes.Equal(data, samples.Instructions) {
+		t.Fatalf("scaffolded file does not match the sample: %v", err)
+	}
+
+	if err := os.WriteFile(path, []byte("mine"), 0644); err != nil {
+		t.Fatalf("failed to write: %v", err)
+	}
+	if _, created, err := ScaffoldInstructions(dir, "instructions.md"); err != nil || created {
+		t.Fatalf("an existing file must be kept, got %v %v", created, err)
+	}
+	if data, _ := os.ReadFile(path); string(data) != "mine" {
+		t.Errorf("existing instructions were overwritten: %q", data)
+	}
+}
+
+func TestWriteConfigKeepsBackup(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "meetsum", "settings.yaml")
+
+	if backup, err := WriteConfig(path, "first"); err != nil || backup != "" {
+		t.Fatalf("first write: backup %q, err %v", backup, err)
+	}
+	backup, err := WriteConfig(path, "second")
+	if err != nil || backup != path+".bak" {
+		t.Fatalf("second write: backup %q, err %v", backup, err)
+	}
+	if data, _ := os.ReadFile(backup); string(data) != "first" {
+		t.Errorf("unexpected backup %q", data)
+	}
+	if data, _ := os.ReadFile(path); string(data) != "second" {
+		t.Errorf("unexpected config %q", data)
+	}
+}
+
+func TestPathChecks(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "skill.md")
+	if err := os.WriteFile(file, []byte("skill"), 0644); err != nil {
+		t.Fatalf("failed to write: %v", err)
+	}
+
+	if err := CheckExistingDir(dir); err != nil {
+		t.Errorf("existing dir: %v", err)
+	}
+	if err := CheckExistingDir(filepath.Join(dir, "missing")); err == nil {
+		t.Error("missing dir should fail")
+	}
+	if err := CheckExistingDir(file); err == nil {
+		t.Error("a file is not a directory")
+	}
+	if err := CheckCreatableDir(filepath.Join(dir, "new", "nested")); err != nil {
+		t.Errorf("creatable dir: %v", err)
+	}
+	if err := CheckCreatableDir(filepath.Join(file, "below")); err == nil {
+		t.Error("a directory below a file cannot be created")
+	}
+	if err := CheckOptionalFile(""); err != nil {
+		t.Errorf("empty optional file: %v", err)
+	}
+	if err := CheckOptionalFile(file); err != nil {
+		t.Errorf("existing file: %v", err)
+	}
+	if err := CheckOptionalFile(dir); err == nil {
+		t.Error("a directory is not a file")
+	}
+	if err := CheckFileName("sub/file.md"); err == nil {
+		t.Error("file names must not contain directories")
+	}
+}
+
+func TestDetectProviders(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"claude", "ollama"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte("#!/bin/sh\n"), 0755); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+	}
+	t.Setenv("PATH", dir)
+
+	found := DetectProviders()
+	if len(found) != 2 || found[0].Name != "claude" || found[1].Name != "ollama" {
+		t.Errorf("unexpected providers: %+v", found)
+	}
+}
@@ -0,0 +1,289 @@
+package slack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// DefaultAPIURL is the Slack Web API base URL used when none is configured.
+const DefaultAPIURL = "https://slack.com/api"
+
+// MaxMessageLength is the per-message character budget used when chunking the
+// full summary into thread replies. Slack truncates long messages well before
+// its hard limit, so this stays conservative.
+const MaxMessageLength = 3900
+
+var markdownLinkRe = regexp.MustCompile(`\[([^\]]+)\]\(([^)\s]+)\)`)
+
+// Target describes where a summary is delivered.
+// BotToken + Channel enables threaded delivery; WebhookURL posts the parent only.
+type Target struct {
+	WebhookURL string
+	BotToken   string
+	Channel    string
+	APIURL     string
+}
+
+// PostResult captures the outcome of a Slack delivery.
+type PostResult struct {
+	Channel   string
+	TS        string
+	Permalink string
+	Replies   int
+	Threaded  bool
+}
+
+// Client delivers summaries to Slack via the Web API or an incoming webhook.
+type Client struct {
+	target     Target
+	httpClient *http.Client
+}
+
+// NewClient creates a Slack client for the given target.
+func NewClient(target Target) *Client {
+	if strings.TrimSpace(target.APIURL) == "" {
+		target.APIURL = DefaultAPIURL
+	}
+	target.APIURL = strings.TrimRight(target.APIURL, "/")
+
+	return &Client{
+		target:     target,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// Validate reports whether the target has enough configuration to post.
+func (t Target) Validate() error {
+	if t.BotToken != "" {
+		if strings.TrimSpace(t.Channel) == "" {
+			return fmt.Errorf("slack.channel is required when slack.bot_token is set")
+		}
+		return nil
+	}
+	if t.WebhookURL != "" {
+		return nil
+	}
+	return fmt.Errorf("slack delivery needs slack.bot_token + slack.channel or slack.webhook_url")
+}
+
+// PostSummary posts the mini summary as the parent message and the full summary
+// as chunked thread replies. Incoming webhooks cannot report the parent message
+// timestamp, so webhook delivery posts the parent only and reports Threaded=false.
+func (c *Client) PostSummary(ctx context.Context, mini, full string) (PostResult, error) {
+	if err := c.target.Validate(); err != nil {
+		return PostResult{}, err
+	}
+
+	if c.target.BotToken == "" {
+		if err := c.postWebhook(ctx, ToMrkdwn(mini)); err != nil {
+			return PostResult{}, err
+		}
+		return PostResult{}, nil
+	}
+
+	parent, err := c.postMessage(ctx, ToMrkdwn(mini), "")
+	if err != nil {
+		return PostResult{}, fmt.Errorf("failed to post slack parent message: %w", err)
+	}
+
+	result := PostResult{Channel: parent.Channel, TS: parent.TS, Threaded: true}
+
+	for _, chunk := range ChunkText(ToMrkdwn(full), MaxMessageLength) {
+		if _, err := c.postMessage(ctx, chunk, parent.TS); err != nil {
+			return result, fmt.Errorf("failed to post slack thread reply %d: %w", result.Replies+1, err)
+		}
+		result.Replies++
+	}
+
+	permalink, err := c.getPermalink(ctx, parent.Channel, parent.TS)
+	if err != nil {
+		return result, fmt.Errorf("failed to fetch slack permalink: %w", err)
+	}
+	result.Permalink = permalink
+
+	return result, nil
+}
+
+type apiResponse struct {
+	OK        bool   `json:"ok"`
+	Error     string `json:"error"`
+	Channel   string `json:"channel"`
+	TS        string `json:"ts"`
+	Permalink string `json:"permalink"`
+}
+
+func (c *Client) postMessage(ctx context.Context, text, threadTS string) (apiResponse, error) {
+	payload := map[string]any{
+		"channel":      c.target.Channel,
+		"text":         text,
+		"mrkdwn":       true,
+		"unfurl_links": false,
+	}
+	if threadTS != "" {
+		payload["thread_ts"] = threadTS
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return apiResponse{}, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.target.APIURL+"/chat.postMessage", bytes.NewReader(body))
+	if err != nil {
+		return apiResponse{}, err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	req.Header.Set("Authorization", "Bearer "+c.target.BotToken)
+
+	return c.doAPI(req)
+}
+
+func (c *Client) getPermalink(ctx context.Context, channel, ts string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.target.APIURL+"/chat.getPermalink", http.NoBody)
+	if err != nil {
+		return "", err
+	}
+	query := req.URL.Query()
+	query.Set("channel", channel)
+	query.Set("message_ts", ts)
+	req.URL.RawQuery = query.Encode()
+	req.Header.Set("Authorization", "Bearer "+c.target.BotToken)
+
+	resp, err := c.doAPI(req)
+	if err != nil {
+		return "", err
+	}
+	return resp.Permalink, nil
+}
+
+func (c *Client) doAPI(req *http.Request) (apiResponse, error) {
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return apiResponse{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return apiResponse{}, fmt.Errorf("slack API returned HTTP %d", resp.StatusCode)
+	}
+
+	var decoded apiResponse
+	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+		return apiResponse{}, fmt.Errorf("failed to decode slack API response: %w", err)
+	}
+	if !decoded.OK {
+		return apiResponse{}, fmt.Errorf("slack API error: %s", decoded.Error)
+	}
+
+	return decoded, nil
+}
+
+func (c *Client) postWebhook(ctx context.Context, text string) error {
+	body, err := json.Marshal(map[string]any{"text": text, "mrkdwn": true})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.target.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post slack webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("slack webhook returned HTTP %d: %s", resp.StatusCode, strings.TrimSpace(string(detail)))
+	}
+
+	return nil
+}
+
+// ToMrkdwn converts standard markdown links to Slack's <url|text> form.
+// Everything else in meetsum summaries is already Slack-compatible.
+func ToMrkdwn(text string) string {
+	return markdownLinkRe.ReplaceAllString(text, "<$2|$1>")
+}
+
+// ChunkText splits text into pieces no longer than limit, preferring paragraph
+// boundaries, then line boundaries, and hard-splitting only oversized lines.
+func ChunkText(text string, limit int) []string {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil
+	}
+	if limit <= 0 || len(text) <= limit {
+		return []string{text}
+	}
+
+	var chunks []string
+	var current strings.Builder
+
+	flush := func() {
+		if current.Len() > 0 {
+			chunks = append(chunks, strings.TrimSpace(current.String()))
+			current.Reset()
+		}
+	}
+
+	appendPiece := func(piece, sep string) {
+		if current.Len() > 0 && current.Len()+len(sep)+len(piece) > limit {
+			flush()
+		}
+		if current.Len() > 0 {
+			current.WriteString(sep)
+		}
+		current.WriteString(piece)
+	}
+
+	for _, paragraph := range strings.Split(text, "\n\n") {
+		if len(paragraph) <= limit {
+			appendPiece(paragraph, "\n\n")
+			continue
+		}
+
+		flush()
+		for _, line := range strings.Split(paragraph, "\n") {
+			for len(line) > limit {
+				cut := splitIndex(line, limit)
+				appendPiece(line[:cut], "\n")
+				flush()
+				line = line[cut:]
+			}
+			appendPiece(line, "\n")
+		}
+		flush()
+	}
+	flush()
+
+	return chunks
+}
+
+// splitIndex finds a cut point at or below limit that does not break a UTF-8
+// sequence, preferring the last space.
+func splitIndex(line string, limit int) int {
+	cut := limit
+	for cut > 0 && !isRuneStart(line[cut]) {
+		cut--
+	}
+	if space := strings.LastIndex(line[:cut], " "); space > limit/2 {
+		return space + 1
+	}
+	return cut
+}
+
+func isRuneStart(b byte) bool {
+	return b&0xC0 != 0x80
+}
@@ -0,0 +1,175 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+type recordedMessage struct {
+	Channel  string `json:"channel"`
+	Text     string `json:"text"`
+	ThreadTS string `json:"thread_ts"`
+}
+
+func newSlackStandIn(t *testing.T) (*httptest.Server, *[]recordedMessage) {
+	t.Helper()
+
+	var mu sync.Mutex
+	messages := []recordedMessage{}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/chat.postMessage", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer xoxb-test" {
+			_ = json.NewEncoder(w).Encode(map[string]any{"ok": false, "error": "invalid_auth"})
+			return
+		}
+
+		var msg recordedMessage
+		if err := json.NewDecoder(r.Body).Decode(&msg); err != nil {
+			t.Errorf("failed to decode message: %v", err)
+		}
+
+		mu.Lock()
+		messages = append(messages, msg)
+		mu.Unlock()
+
+		_ = json.NewEncoder(w).Encode(map[string]any{"ok": true, "channel": msg.Channel, "ts": "1700000000.000100"})
+	})
+	mux.HandleFunc("/chat.getPermalink", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"ok":        true,
+			"permalink": "https://example.slack.com/archives/" + r.URL.Query().Get("channel") + "/p" + r.URL.Query().Get("message_ts"),
+		})
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server, &messages
+}
+
+func TestPostSummaryThreadsFullSummary(t *testing.T) {
+	server, messages := newSlackStandIn(t)
+
+	client := NewClient(Target{BotToken: "xoxb-test", Channel: "C123", APIURL: server.URL})
+	full := strings.Repeat("Paragraph of meeting content.\n\n", 300)
+
+	result, err := client.PostSummary(context.Background(), "*HIGHLIGHTS*\n\n- [Meeting Recording](https://rec.example/1)", full)
+	if err != nil {
+		t.Fatalf("post failed: %v", err)
+	}
+
+	if !result.Threaded {
+		t.Fatal("expected threaded delivery with bot token")
+	}
+	if result.Replies < 2 {
+		t.Fatalf("expected full summary to be chunked into multiple replies, got %d", result.Replies)
+	}
+	if !strings.Contains(result.Permalink, "/archives/C123/p1700000000.000100") {
+		t.Fatalf("unexpected permalink: %s", result.Permalink)
+	}
+
+	got := *messages
+	if len(got) != result.Replies+1 {
+		t.Fatalf("expected %d messages, got %d", result.Replies+1, len(got))
+	}
+	if got[0].ThreadTS != "" {
+		t.Error("parent message should not be threaded")
+	}
+	if !strings.Contains(got[0].Text, "<https://rec.example/1|Meeting Recording>") {
+		t.Errorf("expected markdown link converted to mrkdwn, got %q", got[0].Text)
+	}
+	for i, msg := range got[1:] {
+		if msg.ThreadTS != "1700000000.000100" {
+			t.Errorf("reply %d not threaded under parent: %q", i+1, msg.ThreadTS)
+		}
+		if len(msg.Text) > MaxMessageLength {
+			t.Errorf("reply %d exceeds message budget: %d", i+1, len(msg.Text))
+		}
+	}
+}
+
+func TestPostSummaryReportsAPIError(t *testing.T) {
+	server, _ := newSlackStandIn(t)
+
+	client := NewClient(Target{BotToken: "xoxb-wrong", Channel: "C123", APIURL: server.URL})
+	_, err := client.PostSummary(context.Background(), "mini", "full")
+	if err == nil || !strings.Contains(err.Error(), "invalid_auth") {
+		t.Fatalf("expected invalid_auth error, got %v", err)
+	}
+}
+
+func TestPostSummaryWebhookPostsParentOnly(t *testing.T) {
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&payload)
+		bodies = append(bodies, payload["text"].(string))
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	client := NewClient(Target{WebhookURL: server.URL + "/hook"})
+	result, err := client.PostSummary(context.Background(), "mini summary", "full summary")
+	if err != nil {
+		t.Fatalf("webhook post failed: %v", err)
+	}
+	if result.Threaded {
+		t.Error("webhook delivery cannot be threaded")
+	}
+	if len(bodies) != 1 || bodies[0] != "mini summary" {
+		t.Fatalf("expected only the mini summary to be posted, got %v", bodies)
+	}
+}
+
+func TestTargetValidate(t *testing.T) {
+	if err := (Target{}).Validate(); err == nil {
+		t.Error("expected error for empty target")
+	}
+	if err := (Target{BotToken: "xoxb"}).Validate(); err == nil {
+		t.Error("expected error for bot token without channel")
+	}
+	if err := (Target{WebhookURL: "https://hooks"}).Validate(); err != nil {
+		t.Errorf("unexpected error for webhook target: %v", err)
+	}
+}
+
+func TestChunkText(t *testing.T) {
+	t.Run("short text is a single chunk", func(t *testing.T) {
+		chunks := ChunkText("hello\n\nworld", 100)
+		if len(chunks) != 1 || chunks[0] != "hello\n\nworld" {
+			t.Fatalf("unexpected chunks: %q", chunks)
+		}
+	})
+
+	t.Run("splits at paragraph boundaries", func(t *testing.T) {
+		chunks := ChunkText("aaaa\n\nbbbb\n\ncccc", 10)
+		expected := []string{"aaaa\n\nbbbb", "cccc"}
+		if strings.Join(chunks, "|") != strings.Join(expected, "|") {
+			t.Fatalf("expected %q, got %q", expected, chunks)
+		}
+	})
+
+	t.Run("hard splits oversized lines without breaking runes", func(t *testing.T) {
+		line := strings.Repeat("é", 50)
+		chunks := ChunkText(line, 15)
+		if strings.Join(chunks, "") != line {
+			t.Fatalf("chunks do not reassemble to original")
+		}
+		for _, chunk := range chunks {
+			if len(chunk) > 15 {
+				t.Errorf("chunk exceeds limit: %d", len(chunk))
+			}
+		}
+	})
+
+	t.Run("empty text yields no chunks", func(t *testing.T) {
+		if chunks := ChunkText("  \n", 10); len(chunks) != 0 {
+			t.Fatalf("expected no chunks, got %q", chunks)
+		}
+	})
+}
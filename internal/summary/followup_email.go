@@ -0,0 +1,106 @@
+package summary
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/bashfulrobot/meetsum/config"
+)
+
+// followUpEmailInstructions is the built-in prompt for the customer-facing
+// follow-up email. email.instructions_file replaces it when configured.
+const followUpEmailInstructions = `Write a customer-facing follow-up email recapping the meeting described below.
+
+RULES:
+- Address the customer's attendees directly and write from the sender's first-person perspective.
+- Include only content that is appropriate to share with the customer. Leave out internal-only material: risks, internal opinions, deal strategy, pricing strategy, competitive notes and anything said about the customer rather than to them.
+- Open with one or two sentences thanking them and stating the purpose of the email.
+- Recap the key discussion points as short paragraphs or "-" bullets.
+- List action items grouped by owner, one heading line per owner followed by "-" bullets for that owner's items, keeping any deadlines.
+- Close with a short sign-off using the sender's name.
+- Plain text only. Do NOT use Slack or markdown emphasis such as *bold* or _italics_, and do NOT include a subject line.
+- Output ONLY the email body. No preamble, no code fences, no commentary.`
+
+// GenerateFollowUpEmail runs a second AI pass that turns the validated summary
+// and transcript into a customer-facing follow-up email body.
+func (p *Processor) GenerateFollowUpEmail(summaryContent string) (string, error) {
+	instructions := followUpEmailInstructions
+	if path := p.config.GetEmailInstructionsPath(); path != "" {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("failed to load email instructions: %w", err)
+		}
+		instructions = string(content)
+	}
+
+	transcript, err := p.LoadTranscript()
+	if err != nil {
+		return "", err
+	}
+
+	customerName, _ := p.ExtractCustomerName()
+	date := p.ExtractDateFromPath()
+	if date == "" {
+		date = "UNDATED"
+	}
+
+	prompt := fmt.Sprintf(`%s
+
+Sender: %s
+Customer: %s
+Meeting date: %s
+
+MEETING SUMMARY:
+%s
+
+TRANSCRIPT:
+%s`, instructions, p.userName, customerName, date, summaryContent, transcript)
+
+	result, stderr, err := p.executeAICommand(prompt)
+	if err != nil {
+		p.logCommandError(stderr, err)
+		return "", fmt.Errorf("failed to generate follow-up email: %w", err)
+	}
+
+	body := p.cleanAIOutput(result)
+	if strings.TrimSpace(body) == "" {
+		return "", fmt.Errorf("generated follow-up email is empty after cleaning")
+	}
+	return body, nil
+}
+
+// FollowUpEmailSubject builds the email subject from email.subject_template,
+// falling back to the default template when it expands to nothing.
+func (p *Processor) FollowUpEmailSubject() string {
+	vars := p.TemplateVars()
+	if subject := ExpandText(p.config.Email.SubjectTemplate, vars); subject != "" {
+		return subject
+	}
+	return ExpandText(config.DefaultEmailSubjectTemplate, vars)
+}
+
+// GenerateEmailOutputFilename derives the draft filename from the main summary
+// filename, replacing the .md extension with -email.eml.
+func (p *Processor) GenerateEmailOutputFilename() (string, error) {
+	mainFilename, err := p.SummaryFilename()
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(mainFilename, filepath.Ext(mainFilename)) + "-email.eml", nil
+}
+
+// SaveFollowUpEmail writes the rendered .eml draft to the meeting directory.
+func (p *Processor) SaveFollowUpEmail(message []byte) (string, error) {
+	filename, err := p.GenerateEmailOutputFilename()
+	if err != nil {
+		return "", err
+	}
+
+	outputPath := filepath.Join(p.meetingDir, filename)
+	if err := os.WriteFile(outputPath, message, 0644); err != nil {
+		return "", fmt.Errorf("failed to save follow-up email: %w", err)
+	}
+	return outputPath, nil
+}
@@ -0,0 +1,117 @@
+package summary
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	"github.com/bashfulrobot/meetsum/internal/calendar"
+)
+
+// Invite returns the calendar invite in the meeting directory and its path.
+// The first .ics file is parsed once per meeting directory; a missing or
+// unreadable invite returns nil.
+func (p *Processor) Invite() (*calendar.Invite, string) {
+	if p.inviteLoaded {
+		return p.invite, p.invitePath
+	}
+	p.inviteLoaded = true
+
+	path := calendar.FindInvite(p.meetingDir)
+	if path == "" {
+		return nil, ""
+	}
+	invite, err := calendar.ParseFile(path)
+	if err != nil {
+		if p.logger != nil {
+			p.logger.Warn("ignoring unreadable calendar invite", "path", path, "error", err)
+		}
+		return nil, ""
+	}
+
+	p.invite, p.invitePath = &invite, path
+	return p.invite, p.invitePath
+}
+
+// inviteCustomerName derives the customer from the most common attendee
+// domain outside internalDomains ("acme.com" becomes "Acme"). Without internal
+// domains a colleague's domain could win, so no name is derived. When the
+// folder name refers to the same company its spelling is kept, so "ACME Corp"
+// and "acmecorp.com" do not fight over the filename.
+func inviteCustomerName(invite *calendar.Invite, folderName string, internalDomains []string) string {
+	if len(internalDomains) == 0 {
+		return ""
+	}
+	domain := invite.ExternalDomain(internalDomains...)
+	if domain == "" {
+		return ""
+	}
+	labels := strings.Split(domain, ".")
+	label := labels[0]
+	if len(labels) >= 2 {
+		label = labels[len(labels)-2]
+	}
+	if label == "" {
+		return ""
+	}
+
+	folderKey := alphanumericKey(folderName)
+	if folderKey != "" && (strings.Contains(label, folderKey) || strings.Contains(folderKey, label)) {
+		return folderName
+	}
+
+	runes := []rune(label)
+	runes[0] = unicode.ToUpper(runes[0])
+	return string(runes)
+}
+
+// alphanumericKey lowercases s and drops everything but letters and digits.
+func alphanumericKey(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, s)
+}
+
+// inviteAttendees lists invite attendees by display name, skipping duplicates.
+func inviteAttendees(invite *calendar.Invite) []string {
+	var names []string
+	seen := map[string]bool{}
+	for _, attendee := range invite.Attendees {
+		name := attendee.DisplayName()
+		if name == "" || seen[strings.ToLower(name)] {
+			continue
+		}
+		seen[strings.ToLower(name)] = true
+		names = append(names, name)
+	}
+	return names
+}
+
+// inviteBlock renders the invite title, organizer and attendees for the prompt.
+func (p *Processor) inviteBlock() string {
+	invite, path := p.Invite()
+	if invite == nil {
+		return ""
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "MEETING INVITE (%s):\n", filepath.Base(path))
+	if invite.Title != "" {
+		fmt.Fprintf(&b, "Title: %s\n", invite.Title)
+	}
+	if invite.Organizer.Name != "" || invite.Organizer.Email != "" {
+		fmt.Fprintf(&b, "Organizer: %s\n", invite.Organizer)
+	}
+	if len(invite.Attendees) > 0 {
+		b.WriteString("Attendees:\n")
+		for _, attendee := range invite.Attendees {
+			fmt.Fprintf(&b, "- %s\n", attendee)
+		}
+	}
+	b.WriteString("Use the invite to identify speakers and attendees by their real names.")
+	return b.String()
+}
@@ -0,0 +1,133 @@
+package summary
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/bashfulrobot/meetsum/internal/calendar"
+)
+
+const testInvite = `BEGIN:VCALENDAR
+BEGIN:VEVENT
+DTSTART;VALUE=DATE:20251003
+SUMMARY:Acme Corp onboarding
+ORGANIZER;CN=Dustin:mailto:dustin@konghq.com
+ATTENDEE;CN=Sam Lee:mailto:sam@acmecorp.com
+ATTENDEE:mailto:pat@acmecorp.com
+ATTENDEE;CN=Dustin:mailto:dustin@konghq.com
+END:VEVENT
+END:VCALENDAR
+`
+
+func writeInviteMeeting(t *testing.T, customer, date string) string {
+	t.Helper()
+	meetingDir := filepath.Join(t.TempDir(), "Customers", customer, date)
+	if err := os.MkdirAll(meetingDir, 0755); err != nil {
+		t.Fatalf("failed to create meeting dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(meetingDir, "invite.ics"), []byte(testInvite), 0644); err != nil {
+		t.Fatalf("failed to write invite: %v", err)
+	}
+	return meetingDir
+}
+
+func TestInvitePreferredOverPath(t *testing.T) {
+	meetingDir := writeInviteMeeting(t, "ACME Corp", "2025-10-01")
+	p := newTestProcessor(t, meetingDir)
+
+	if date := p.ExtractDateFromPath(); date != "2025-10-03" {
+		t.Errorf("expected invite date, got %q", date)
+	}
+	if proper, upper := p.ExtractCustomerName(); proper != "ACME Corp" || upper != "ACME CORP" {
+		t.Errorf("expected folder spelling of the invite customer, got %q / %q", proper, upper)
+	}
+	if got, want := p.LoadAttendees(), []string{"Sam Lee", "pat", "Dustin"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected invite attendees %v, got %v", want, got)
+	}
+	if files := p.GetOptionalFiles(); len(files) != 1 || !strings.Contains(files[0], "invite.ics") {
+		t.Errorf("expected invite in optional files, got %v", files)
+	}
+
+	block := p.inviteBlock()
+	for _, want := range []string{"Title: Acme Corp onboarding", "Organizer: Dustin <dustin@konghq.com>", "- Sam Lee <sam@acmecorp.com>"} {
+		if !strings.Contains(block, want) {
+			t.Errorf("expected prompt block to contain %q, got:\n%s", want, block)
+		}
+	}
+}
+
+func TestInviteCustomerFromDomain(t *testing.T) {
+	meetingDir := filepath.Join(t.TempDir(), "Inbox", "2025-10-01")
+	if err := os.MkdirAll(meetingDir, 0755); err != nil {
+		t.Fatalf("failed to create meeting dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(meetingDir, "invite.ics"), []byte(testInvite), 0644); err != nil {
+		t.Fatalf("failed to write invite: %v", err)
+	}
+
+	p := newTestProcessor(t, meetingDir)
+	if proper, _ := p.ExtractCustomerName(); proper != "Inbox" {
+		t.Errorf("expected the folder name without internal domains, got %q", proper)
+	}
+
+	p.config.User.InternalDomains = []string{"@KongHQ.com"}
+	if proper, _ := p.ExtractCustomerName(); proper != "Acmecorp" {
+		t.Errorf("expected customer from attendee domain, got %q", proper)
+	}
+
+	if err := os.WriteFile(filepath.Join(meetingDir, attendeesFile), []byte("Alex\n"), 0644); err != nil {
+		t.Fatalf("failed to write attendees: %v", err)
+	}
+	if got := p.LoadAttendees(); !reflect.DeepEqual(got, []string{"Alex"}) {
+		t.Errorf("expected attendees.txt to win over the invite, got %v", got)
+	}
+}
+
+func TestInviteCustomerKeepsFolder(t *testing.T) {
+	meetingDir := writeInviteMeeting(t, "Unsorted", "2025-10-01")
+	p := newTestProcessor(t, meetingDir)
+	p.config.User.InternalDomains = []string{"konghq.com"}
+
+	if proper, _ := p.ExtractCustomerName(); proper != "Unsorted" {
+		t.Errorf("expected the customer folder to win, got %q", proper)
+	}
+}
+
+func TestInviteCustomerIgnoresPersonalAndEmptyDomains(t *testing.T) {
+	invite := &calendar.Invite{
+		Organizer: calendar.Person{Email: "dustin@konghq.com"},
+		Attendees: []calendar.Person{{Email: "sam@gmail.com"}, {Email: "pat@gmail.com"}, {Email: "lee@eu.konghq.com"}},
+	}
+	if name := inviteCustomerName(invite, "Inbox", []string{"konghq.com"}); name != "" {
+		t.Errorf("expected no customer from personal or internal domains, got %q", name)
+	}
+
+	invite.Attendees = []calendar.Person{{Email: "sam@.com"}}
+	if name := inviteCustomerName(invite, "Inbox", []string{"konghq.com"}); name != "" {
+		t.Errorf("expected no customer from an empty domain label, got %q", name)
+	}
+}
+
+func TestUnreadableInviteIgnored(t *testing.T) {
+	meetingDir := filepath.Join(t.TempDir(), "Customers", "Acme", "2025-10-01")
+	if err := os.MkdirAll(meetingDir, 0755); err != nil {
+		t.Fatalf("failed to create meeting dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(meetingDir, "invite.ics"), []byte("not a calendar"), 0644); err != nil {
+		t.Fatalf("failed to write invite: %v", err)
+	}
+
+	p := newTestProcessor(t, meetingDir)
+	if date := p.ExtractDateFromPath(); date != "2025-10-01" {
+		t.Errorf("expected path date, got %q", date)
+	}
+	if proper, _ := p.ExtractCustomerName(); proper != "Acme" {
+		t.Errorf("expected path customer, got %q", proper)
+	}
+	if p.inviteBlock() != "" {
+		t.Error("expected no invite block")
+	}
+}
@@ -0,0 +1,254 @@
+package summary
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Lint rule identifiers.
+const (
+	RuleDoubleAsteriskBold = "double-asterisk-bold"
+	RuleBulletStyle        = "bullet-style"
+	RuleMarkdownHeading    = "markdown-heading"
+	RuleHeaderCase         = "header-case"
+	RuleBlankAroundHeader  = "blank-around-header"
+	RuleTrailingWhitespace = "trailing-whitespace"
+	RuleTrailingNewline    = "trailing-newline"
+	RuleCodeFence          = "code-fence"
+	RuleMissingSection     = "missing-section"
+	RuleActionAssignee     = "action-item-assignee"
+)
+
+// LintIssue is a single rule violation. Line is 1-based; 0 means document-level.
+type LintIssue struct {
+	Line    int
+	Rule    string
+	Message string
+	Fixable bool
+}
+
+func (i LintIssue) String() string {
+	fixable := ""
+	if i.Fixable {
+		fixable = " (fixable)"
+	}
+	if i.Line == 0 {
+		return fmt.Sprintf("%s: %s%s", i.Rule, i.Message, fixable)
+	}
+	return fmt.Sprintf("line %d: %s: %s%s", i.Line, i.Rule, i.Message, fixable)
+}
+
+var (
+	doubleAsteriskRe  = regexp.MustCompile(`\*\*([^*\n]+?)\*\*`)
+	altBulletRe       = regexp.MustCompile(`^(\s*)(?:•\s*|[*+]\s+)`)
+	markdownHeadingRe = regexp.MustCompile(`^#{1,6}\s+(.+?)\s*#*$`)
+	anyHeaderRe       = regexp.MustCompile(`^(\*_|\*|_)([^*_].*?)(_\*|\*|_)$`)
+)
+
+// knownSections are the *SECTION* headers summaries use. Other fully bold
+// lines, such as *Important*, are emphasis and are left alone.
+var knownSections = []string{"HIGHLIGHTS", "ACTION ITEMS", "RISKS", "MEETING RECORDING", "FULL MEETING SUMMARY"}
+
+// requiredSections are the bold sections every summary must contain, keyed by
+// ParseSections name.
+var requiredSections = []struct {
+	key    string
+	header string
+}{
+	{"title", "*_TITLE_*"},
+	{"highlights", "*HIGHLIGHTS*"},
+	{"action-items", "*ACTION ITEMS*"},
+	{"meeting-recording", "*MEETING RECORDING*"},
+}
+
+// LintSummary checks summary content against the Slack formatting rules from
+// the LLM instructions file and returns issues ordered by line.
+func LintSummary(content string) []LintIssue {
+	var issues []LintIssue
+	lines := strings.Split(content, "\n")
+	inFence := false
+
+	for i, line := range lines {
+		lineNo := i + 1
+		trimmed := strings.TrimSpace(line)
+
+		if strings.HasPrefix(trimmed, "```") {
+			issues = append(issues, LintIssue{lineNo, RuleCodeFence, "code fences are not part of the summary format", false})
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+
+		if line != strings.TrimRight(line, " \t") {
+			issues = append(issues, LintIssue{lineNo, RuleTrailingWhitespace, "line has trailing whitespace", true})
+		}
+		if doubleAsteriskRe.MatchString(line) {
+			issues = append(issues, LintIssue{lineNo, RuleDoubleAsteriskBold, "use *text* for bold, never **text**", true})
+		}
+		if altBulletRe.MatchString(line) && !isHeaderLine(trimmed) {
+			issues = append(issues, LintIssue{lineNo, RuleBulletStyle, "use - for bullet points", true})
+		}
+		if markdownHeadingRe.MatchString(trimmed) {
+			issues = append(issues, LintIssue{lineNo, RuleMarkdownHeading, "use Slack headers (*HEADER* or _TOPIC_) instead of # headings", true})
+			continue
+		}
+
+		if !isHeaderLine(trimmed) {
+			continue
+		}
+		if inner := headerText(trimmed); inner != strings.ToUpper(inner) {
+			issues = append(issues, LintIssue{lineNo, RuleHeaderCase, "section headers must be ALL CAPS", true})
+		}
+		if i+1 < len(lines) && strings.TrimSpace(lines[i+1]) != "" {
+			issues = append(issues, LintIssue{lineNo, RuleBlankAroundHeader, "add a blank line after the header", true})
+		}
+		if i > 0 && strings.TrimSpace(lines[i-1]) != "" {
+			issues = append(issues, LintIssue{lineNo, RuleBlankAroundHeader, "add a blank line before the header", true})
+		}
+	}
+
+	if content != "" && (!strings.HasSuffix(content, "\n") || strings.HasSuffix(content, "\n\n")) {
+		issues = append(issues, LintIssue{len(lines), RuleTrailingNewline, "file must end with exactly one newline", true})
+	}
+
+	sections := ParseSections(content)
+	for _, required := range requiredSections {
+		if _, ok := sections[required.key]; !ok {
+			issues = append(issues, LintIssue{0, RuleMissingSection, fmt.Sprintf("missing %s section", required.header), false})
+		}
+	}
+
+	issues = append(issues, lintActionItems(lines)...)
+
+	sort.SliceStable(issues, func(a, b int) bool { return issues[a].Line < issues[b].Line })
+	return issues
+}
+
+// FixSummary applies every mechanical fix and returns the corrected content
+// along with the issues that remain and need a human.
+func FixSummary(content string) (string, []LintIssue) {
+	lines := strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
+	fixed := make([]string, 0, len(lines))
+	inFence := false
+
+	for _, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inFence = !inFence
+			fixed = append(fixed, line)
+			continue
+		}
+		if inFence {
+			fixed = append(fixed, line)
+			continue
+		}
+
+		line = strings.TrimRight(line, " \t")
+		line = doubleAsteriskRe.ReplaceAllString(line, "*$1*")
+
+		trimmed := strings.TrimSpace(line)
+		if match := markdownHeadingRe.FindStringSubmatch(trimmed); match != nil {
+			line = "*" + strings.ToUpper(strings.Trim(match[1], "*_ ")) + "*"
+		} else if isHeaderLine(trimmed) {
+			line = upperHeader(trimmed)
+		} else if altBulletRe.MatchString(line) {
+			line = altBulletRe.ReplaceAllString(line, "$1- ")
+		}
+
+		fixed = append(fixed, line)
+	}
+
+	fixed = spaceHeaders(fixed)
+	result := strings.TrimRight(strings.Join(fixed, "\n"), "\n") + "\n"
+
+	var remaining []LintIssue
+	for _, issue := range LintSummary(result) {
+		if !issue.Fixable {
+			remaining = append(remaining, issue)
+		}
+	}
+	return result, remaining
+}
+
+// spaceHeaders ensures exactly one blank line around each header and drops
+// leading blank lines.
+func spaceHeaders(lines []string) []string {
+	var out []string
+	for i, line := range lines {
+		header := isHeaderLine(strings.TrimSpace(line))
+		if header && len(out) > 0 && strings.TrimSpace(out[len(out)-1]) != "" {
+			out = append(out, "")
+		}
+		if strings.TrimSpace(line) == "" && len(out) == 0 {
+			continue
+		}
+		out = append(out, line)
+		if header && i+1 < len(lines) && strings.TrimSpace(lines[i+1]) != "" {
+			out = append(out, "")
+		}
+	}
+	return out
+}
+
+func lintActionItems(lines []string) []LintIssue {
+	var issues []LintIssue
+	inActions := false
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if isHeaderLine(trimmed) {
+			inActions = strings.EqualFold(headerText(trimmed), "ACTION ITEMS")
+			continue
+		}
+		if !inActions || !strings.HasPrefix(trimmed, "- ") {
+			continue
+		}
+		if before, _, found := strings.Cut(strings.TrimPrefix(trimmed, "- "), ":"); !found || strings.TrimSpace(before) == "" {
+			issues = append(issues, LintIssue{i + 1, RuleActionAssignee, "action items must use '- Assignee: task'", false})
+		}
+	}
+	return issues
+}
+
+// maxHeaderLength keeps fully emphasized sentences from being treated as headers.
+const maxHeaderLength = 80
+
+// isHeaderLine reports whether a trimmed line is a Slack section header,
+// spanning the whole line: the *_TITLE_*, a known *SECTION* in any case, or an
+// ALL CAPS _TOPIC_. Only these are checked and fixed as headers.
+func isHeaderLine(trimmed string) bool {
+	match := anyHeaderRe.FindStringSubmatch(trimmed)
+	if match == nil || len(match[2]) > maxHeaderLength || strings.HasSuffix(match[2], ".") {
+		return false
+	}
+	open, closing := match[1], match[3]
+	switch open {
+	case "*_":
+		return closing == "_*"
+	case "*":
+		return closing == "*" && !strings.Contains(match[2], "*") && isKnownSection(match[2])
+	default:
+		return closing == "_" && italicHeaderRe.MatchString(trimmed)
+	}
+}
+
+// isKnownSection reports whether name is one of knownSections, ignoring case.
+func isKnownSection(name string) bool {
+	for _, known := range knownSections {
+		if strings.EqualFold(strings.TrimSpace(name), known) {
+			return true
+		}
+	}
+	return false
+}
+
+func headerText(trimmed string) string {
+	return anyHeaderRe.FindStringSubmatch(trimmed)[2]
+}
+
+func upperHeader(trimmed string) string {
+	match := anyHeaderRe.FindStringSubmatch(trimmed)
+	return match[1] + strings.ToUpper(match[2]) + match[3]
+}
@@ -0,0 +1,138 @@
+package summary
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLintSummary(t *testing.T) {
+	t.Run("well formed summary is clean", func(t *testing.T) {
+		if issues := LintSummary(testSummaryAllSections + "\n"); len(issues) != 0 {
+			t.Errorf("expected no issues, got %v", issues)
+		}
+	})
+
+	cases := []struct {
+		name    string
+		content string
+		rule    string
+		line    int
+	}{
+		{"double asterisk", "*HIGHLIGHTS*\n\n- **Acme** renewed.\n", RuleDoubleAsteriskBold, 3},
+		{"bullet glyph", "*HIGHLIGHTS*\n\n• Acme renewed.\n", RuleBulletStyle, 3},
+		{"asterisk bullet", "*HIGHLIGHTS*\n\n* Acme renewed.\n", RuleBulletStyle, 3},
+		{"markdown heading", "## Highlights\n\n- Acme renewed.\n", RuleMarkdownHeading, 1},
+		{"lowercase header", "*Highlights*\n\n- Acme renewed.\n", RuleHeaderCase, 1},
+		{"no blank after header", "*HIGHLIGHTS*\n- Acme renewed.\n", RuleBlankAroundHeader, 1},
+		{"trailing whitespace", "*HIGHLIGHTS*\n\n- Acme renewed.  \n", RuleTrailingWhitespace, 3},
+		{"missing trailing newline", "*HIGHLIGHTS*\n\n- Acme renewed.", RuleTrailingNewline, 3},
+		{"action without assignee", "*ACTION ITEMS*\n\n- Review the plan.\n", RuleActionAssignee, 3},
+		{"missing section", "*HIGHLIGHTS*\n\n- Acme renewed.\n", RuleMissingSection, 0},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, issue := range LintSummary(tc.content) {
+				if issue.Rule == tc.rule && issue.Line == tc.line {
+					return
+				}
+			}
+			t.Errorf("expected %s on line %d, got %v", tc.rule, tc.line, LintSummary(tc.content))
+		})
+	}
+
+	t.Run("emphasized sentence is not a header", func(t *testing.T) {
+		content := "*HIGHLIGHTS*\n\n_This is an emphasized sentence._\n"
+		for _, issue := range LintSummary(content) {
+			if issue.Rule == RuleHeaderCase {
+				t.Errorf("unexpected header issue: %v", issue)
+			}
+		}
+	})
+
+	t.Run("emphasized word is not a header", func(t *testing.T) {
+		content := "*HIGHLIGHTS*\n\n- Acme renewed.\n*Important*\n_Note_\n"
+		for _, issue := range LintSummary(content) {
+			if issue.Rule == RuleHeaderCase || issue.Rule == RuleBlankAroundHeader {
+				t.Errorf("unexpected header issue: %v", issue)
+			}
+		}
+		if fixed, _ := FixSummary(content); !strings.Contains(fixed, "- Acme renewed.\n*Important*\n_Note_\n") {
+			t.Errorf("expected emphasis left alone, got:\n%s", fixed)
+		}
+	})
+
+	t.Run("saved content ends with a newline", func(t *testing.T) {
+		for _, issue := range LintSummary(SavedContent(testSummaryAllSections)) {
+			if issue.Rule == RuleTrailingNewline {
+				t.Errorf("unexpected trailing newline issue: %v", issue)
+			}
+		}
+	})
+}
+
+func TestFixSummary(t *testing.T) {
+	t.Run("well formed summary is unchanged", func(t *testing.T) {
+		fixed, remaining := FixSummary(testSummaryAllSections)
+		if fixed != testSummaryAllSections+"\n" {
+			t.Errorf("expected content preserved, got:\n%s", fixed)
+		}
+		if len(remaining) != 0 {
+			t.Errorf("expected no remaining issues, got %v", remaining)
+		}
+	})
+
+	messy := `
+
+## 2026-02-23 Acme Cadence Call Summary
+*Highlights*
+• **Acme** is moving forward.
+* The integration ships in Q2.
+*Action Items*
+
+- John Doe: Send the proposal.   
+- Review the rollout plan.
+*MEETING RECORDING*
+
+- [Meeting Recording](PLACEHOLDER_URL)
+
+`
+
+	fixed, remaining := FixSummary(messy)
+	expected := `*2026-02-23 ACME CADENCE CALL SUMMARY*
+
+*HIGHLIGHTS*
+
+- *Acme* is moving forward.
+- The integration ships in Q2.
+
+*ACTION ITEMS*
+
+- John Doe: Send the proposal.
+- Review the rollout plan.
+
+*MEETING RECORDING*
+
+- [Meeting Recording](PLACEHOLDER_URL)
+`
+	if fixed != expected {
+		t.Errorf("unexpected fix result:\n%s", fixed)
+	}
+
+	rules := make([]string, 0, len(remaining))
+	for _, issue := range remaining {
+		if issue.Fixable {
+			t.Errorf("fixable issue left behind: %v", issue)
+		}
+		rules = append(rules, issue.Rule)
+	}
+	joined := strings.Join(rules, ",")
+	if !strings.Contains(joined, RuleActionAssignee) || !strings.Contains(joined, RuleMissingSection) {
+		t.Errorf("expected assignee and missing title issues, got %v", remaining)
+	}
+
+	again, _ := FixSummary(fixed)
+	if again != fixed {
+		t.Errorf("expected FixSummary to be idempotent, got:\n%s", again)
+	}
+}
@@ -0,0 +1,92 @@
+package summary
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// SetMeetingType sets an explicit meeting type, overriding the meeting type
+// file and the configured defaults. An empty value clears the override.
+func (p *Processor) SetMeetingType(meetingType string) {
+	p.meetingType = NormalizeMeetingType(meetingType)
+}
+
+// MeetingType resolves the meeting type for the current directory.
+// Priority: SetMeetingType > meeting type file > the customer's entry in
+// meetings.customers > meetings.default_type.
+func (p *Processor) MeetingType() string {
+	if p.meetingType != "" {
+		return p.meetingType
+	}
+	if meetingType := p.readMeetingTypeFile(); meetingType != "" {
+		return meetingType
+	}
+	if p.meetingDir != "" {
+		if meetingType := NormalizeMeetingType(p.config.GetCustomerMeetingType(p.customerFolderName())); meetingType != "" {
+			return meetingType
+		}
+	}
+	return NormalizeMeetingType(p.config.GetDefaultMeetingType())
+}
+
+// NormalizeMeetingType lowercases a meeting type and joins words with hyphens
+// so "Discovery Call" and "discovery-call" name the same type.
+func NormalizeMeetingType(meetingType string) string {
+	return strings.Join(strings.Fields(strings.ToLower(meetingType)), "-")
+}
+
+// readMeetingTypeFile returns the first non-empty line of the meeting type file
+// in the meeting directory, or "" when there is none.
+func (p *Processor) readMeetingTypeFile() string {
+	if p.meetingDir == "" {
+		return ""
+	}
+	content, err := os.ReadFile(filepath.Join(p.meetingDir, p.config.GetMeetingTypeFile()))
+	if err != nil {
+		return ""
+	}
+	for _, line := range strings.Split(string(content), "\n") {
+		if meetingType := NormalizeMeetingType(line); meetingType != "" {
+			return meetingType
+		}
+	}
+	return ""
+}
+
+// instructionsPath returns the instructions file for the resolved meeting type.
+func (p *Processor) instructionsPath() string {
+	return p.config.GetInstructionsPathForType(p.MeetingType())
+}
+
+// promptExtrasBlock returns the meeting type's prompt_extras as a block that
+// follows the instructions, or "" when the type has none.
+func (p *Processor) promptExtrasBlock() string {
+	extras := strings.TrimSpace(p.config.GetPromptExtrasForType(p.MeetingType()))
+	if extras == "" {
+		return ""
+	}
+	return fmt.Sprintf("\n\nADDITIONAL INSTRUCTIONS (%s meetings):\n%s", p.MeetingType(), extras)
+}
+
+// BuildSlackSummary builds the Slack mini summary from a full summary, in the
+// meeting type's slack_sections order when it sets one.
+func (p *Processor) BuildSlackSummary(content string) string {
+	return BuildSlackSummaryInOrder(ParseSections(content), p.config.GetSlackSectionsForType(p.MeetingType()))
+}
+
+// transcriptStem returns the transcript filename without its extension, using
+// the validated transcript when available.
+func (p *Processor) transcriptStem() string {
+	transcriptPath := p.transcriptPath
+	if transcriptPath == "" {
+		found, err := p.FindTranscriptFile()
+		if err != nil {
+			return ""
+		}
+		transcriptPath = found
+	}
+	name := filepath.Base(transcriptPath)
+	return strings.TrimSuffix(name, filepath.Ext(name))
+}
@@ -0,0 +1,234 @@
+package summary
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/bashfulrobot/meetsum/config"
+)
+
+func TestGenerateOutputFilenameWithMeetingTypes(t *testing.T) {
+	newMeeting := func(t *testing.T) (*Processor, string) {
+		t.Helper()
+		meetingDir := filepath.Join(t.TempDir(), "Customers", "Acme", "2026-03-02")
+		if err := os.MkdirAll(meetingDir, 0755); err != nil {
+			t.Fatalf("failed to create meeting dir: %v", err)
+		}
+		if err := os.WriteFile(filepath.Join(meetingDir, "zoom-export.txt"), []byte("transcript"), 0644); err != nil {
+			t.Fatalf("failed to write transcript: %v", err)
+		}
+		return newTestProcessor(t, meetingDir), meetingDir
+	}
+
+	t.Run("default type keeps historical name", func(t *testing.T) {
+		processor, _ := newMeeting(t)
+		filename, err := processor.GenerateOutputFilename()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if filename != "2026-03-02-Acme-cadence-call-summary.md" {
+			t.Fatalf("unexpected filename: %s", filename)
+		}
+	})
+
+	t.Run("explicit type overrides type file", func(t *testing.T) {
+		processor, meetingDir := newMeeting(t)
+		if err := os.WriteFile(filepath.Join(meetingDir, config.DefaultMeetingTypeFile), []byte("\nQBR\n"), 0644); err != nil {
+			t.Fatalf("failed to write type file: %v", err)
+		}
+
+		if got := processor.MeetingType(); got != "qbr" {
+			t.Fatalf("expected type from file, got %q", got)
+		}
+
+		processor.SetMeetingType("Discovery Call")
+		slackFilename, err := processor.GenerateSlackOutputFilename()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if slackFilename != "2026-03-02-Acme-discovery-call-summary-slack.md" {
+			t.Fatalf("unexpected slack filename: %s", slackFilename)
+		}
+	})
+
+	t.Run("template variables", func(t *testing.T) {
+		processor, _ := newMeeting(t)
+		processor.config.Output.FilenameTemplate = "{customer}_{type}_{transcript}_{user}"
+		filename, err := processor.GenerateOutputFilename()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if filename != "Acme_cadence-call_zoom-export_Test User.md" {
+			t.Fatalf("unexpected filename: %s", filename)
+		}
+	})
+
+	t.Run("per-type template and instructions", func(t *testing.T) {
+		processor, _ := newMeeting(t)
+		qbrInstructions := filepath.Join(processor.config.Paths.AutomationDir, "qbr.md")
+		if err := os.WriteFile(qbrInstructions, []byte("QBR instructions"), 0644); err != nil {
+			t.Fatalf("failed to write instructions: %v", err)
+		}
+		processor.config.Meetings.Types = map[string]config.MeetingType{
+			"qbr": {InstructionsFile: "qbr.md", FilenameTemplate: "{date} {customer} QBR.md"},
+		}
+		processor.SetMeetingType("QBR")
+
+		filename, err := processor.GenerateOutputFilename()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if filename != "2026-03-02 Acme QBR.md" {
+			t.Fatalf("unexpected filename: %s", filename)
+		}
+
+		if err := processor.ValidateRequiredFiles(); err != nil {
+			t.Fatalf("unexpected validation error: %v", err)
+		}
+		instructions, err := processor.LoadInstructions()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if instructions != "QBR instructions" {
+			t.Fatalf("expected type-specific instructions, got %q", instructions)
+		}
+	})
+
+	t.Run("template with directories is rejected", func(t *testing.T) {
+		processor, _ := newMeeting(t)
+		processor.config.Output.FilenameTemplate = "../{customer}.md"
+		if _, err := processor.GenerateOutputFilename(); err == nil || !strings.Contains(err.Error(), "directories") {
+			t.Fatalf("expected directory error, got %v", err)
+		}
+	})
+}
+
+func TestMeetingTypeProfiles(t *testing.T) {
+	meetingDir := filepath.Join(t.TempDir(), "Customers", "Acme", "2026-03-02")
+	if err := os.MkdirAll(meetingDir, 0755); err != nil {
+		t.Fatalf("failed to create meeting dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(meetingDir, "zoom-export.txt"), []byte("transcript"), 0644); err != nil {
+		t.Fatalf("failed to write transcript: %v", err)
+	}
+	processor := newTestProcessor(t, meetingDir)
+	if err := os.WriteFile(filepath.Join(processor.config.Paths.AutomationDir, "exec-voice.md"), []byte("Be brief."), 0644); err != nil {
+		t.Fatalf("failed to write skill: %v", err)
+	}
+	processor.config.Meetings.Types = map[string]config.MeetingType{
+		"exec-briefing": {
+			PromptExtras:  "Lead with the business outcome.",
+			SlackSections: []string{"risks", "highlights"},
+			WritingSkill:  "exec-voice.md",
+		},
+	}
+	processor.config.Meetings.Customers = map[string]string{"acme": "Exec Briefing"}
+
+	if got := processor.MeetingType(); got != "exec-briefing" {
+		t.Fatalf("expected the customer default, got %q", got)
+	}
+
+	if err := processor.ValidateRequiredFiles(); err != nil {
+		t.Fatalf("unexpected validation error: %v", err)
+	}
+	prompt, err := processor.BuildPrompt()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(prompt.Text, "test instructions\n\nADDITIONAL INSTRUCTIONS (exec-briefing meetings):\nLead with the business outcome.") {
+		t.Errorf("prompt is missing the profile extras:\n%s", prompt.Text)
+	}
+	if !strings.Contains(prompt.Text, "(exec-voice skill)") || !strings.Contains(prompt.Text, "Be brief.") {
+		t.Errorf("prompt is missing the profile writing skill:\n%s", prompt.Text)
+	}
+
+	slack := processor.BuildSlackSummary("*_TITLE_*\n\n*HIGHLIGHTS*\n- up\n\n*RISKS*\n- down")
+	if strings.Index(slack, "*RISKS*") > strings.Index(slack, "*HIGHLIGHTS*") {
+		t.Errorf("expected the profile's Slack order:\n%s", slack)
+	}
+
+	if err := os.WriteFile(filepath.Join(meetingDir, config.DefaultMeetingTypeFile), []byte("qbr\n"), 0644); err != nil {
+		t.Fatalf("failed to write type file: %v", err)
+	}
+	if got := processor.MeetingType(); got != "qbr" {
+		t.Fatalf("expected the type file to win over the customer default, got %q", got)
+	}
+	prompt, err = processor.BuildPrompt()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Contains(prompt.Text, "ADDITIONAL INSTRUCTIONS") || strings.Contains(prompt.Text, "exec-voice") {
+		t.Errorf("another type must not use the profile's extras or skill:\n%s", prompt.Text)
+	}
+}
+
+func TestSavedFilenamesSurviveTemplateChanges(t *testing.T) {
+	meetingDir := filepath.Join(t.TempDir(), "Customers", "Acme", "2026-03-02")
+	if err := os.MkdirAll(meetingDir, 0755); err != nil {
+		t.Fatalf("failed to create meeting dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(meetingDir, "zoom-export.txt"), []byte("transcript"), 0644); err != nil {
+		t.Fatalf("failed to write transcript: %v", err)
+	}
+
+	processor := newTestProcessor(t, meetingDir)
+	processor.config.Output.FilenameTemplate = "{customer}-{transcript}-{user}.md"
+	if err := processor.ValidateRequiredFiles(); err != nil {
+		t.Fatalf("unexpected validation error: %v", err)
+	}
+	summaryPath, err := processor.SaveSummary("*_SUMMARY_*")
+	if err != nil {
+		t.Fatalf("failed to save summary: %v", err)
+	}
+	slackPath, err := processor.SaveSlackSummary("*_SUMMARY_*")
+	if err != nil {
+		t.Fatalf("failed to save slack summary: %v", err)
+	}
+	if filepath.Base(summaryPath) != "Acme-zoom-export-Test User.md" {
+		t.Fatalf("unexpected summary filename: %s", summaryPath)
+	}
+	if _, err := processor.RenameTranscriptFile(); err != nil {
+		t.Fatalf("failed to rename transcript: %v", err)
+	}
+
+	// A later command without the run's user name or original transcript
+	later := NewProcessor(processor.config, nil)
+	later.SetMeetingDir(meetingDir)
+	if _, path, err := later.LoadSavedSummary(); err != nil || path != summaryPath {
+		t.Fatalf("expected the saved summary %s, got %s, %v", summaryPath, path, err)
+	}
+	if path, err := later.SlackSummaryPath(); err != nil || path != slackPath {
+		t.Fatalf("expected the saved slack summary %s, got %s, %v", slackPath, path, err)
+	}
+
+	// A run with another type writes under that type's name and is read back
+	rerun := NewProcessor(processor.config, nil)
+	rerun.SetMeetingDir(meetingDir)
+	rerun.SetUserName("Test User")
+	rerun.SetMeetingType("qbr")
+	processor.config.Output.FilenameTemplate = "{date}-{customer}-{type}-summary.md"
+	rerunPath, err := rerun.SaveSummary("*_SUMMARY_*")
+	if err != nil {
+		t.Fatalf("failed to save summary: %v", err)
+	}
+	if filepath.Base(rerunPath) != "2026-03-02-Acme-qbr-summary.md" {
+		t.Fatalf("expected the new type's filename, got %s", rerunPath)
+	}
+	if _, path, err := later.LoadSavedSummary(); err != nil || path != rerunPath {
+		t.Fatalf("expected the new summary %s, got %s, %v", rerunPath, path, err)
+	}
+	if path, err := later.SlackSummaryPath(); err != nil || filepath.Base(path) != "2026-03-02-Acme-qbr-summary-slack.md" {
+		t.Fatalf("expected the slack name to follow the new summary, got %s, %v", path, err)
+	}
+	summaryPath = rerunPath
+
+	// Once the summary is gone the template is expanded again
+	if err := os.Remove(summaryPath); err != nil {
+		t.Fatalf("failed to remove summary: %v", err)
+	}
+	if filename, err := later.SummaryFilename(); err != nil || filename != "2026-03-02-Acme-cadence-call-summary.md" {
+		t.Fatalf("expected a freshly expanded filename, got %q, %v", filename, err)
+	}
+}
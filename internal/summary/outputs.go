@@ -0,0 +1,60 @@
+package summary
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// outputsFile records the summary filenames a run saved. Filename templates
+// can use values that change after the run, such as {transcript} once the
+// transcript is renamed or {user} when no name is given, so the saved names
+// are read back instead of expanding the template again.
+const outputsFile = "outputs.json"
+
+// savedOutputs is the content of outputsFile.
+type savedOutputs struct {
+	Summary string `json:"summary"`
+	Slack   string `json:"slack,omitempty"`
+}
+
+// loadSavedOutputs returns the recorded filenames, or empty names when none
+// were recorded.
+func (p *Processor) loadSavedOutputs() savedOutputs {
+	var outputs savedOutputs
+	if p.meetingDir == "" {
+		return outputs
+	}
+	if err := ReadStateFile(p.meetingDir, outputsFile, &outputs); err != nil {
+		return savedOutputs{}
+	}
+	// Only plain filenames in the meeting directory are trusted
+	if !plainFilename(outputs.Summary) || !plainFilename(outputs.Slack) {
+		return savedOutputs{}
+	}
+	return outputs
+}
+
+// recordOutputs stores the summary and Slack filenames for later commands.
+func (p *Processor) recordOutputs(outputs savedOutputs) {
+	if _, err := WriteStateFile(p.meetingDir, outputsFile, outputs); err != nil && p.logger != nil {
+		p.logger.Warn("failed to record summary filenames", "error", err)
+	}
+}
+
+// SummaryFilename returns the filename of the meeting's saved main summary:
+// the one recorded by the last save while that file exists, otherwise the
+// filename template expanded now. It is for reading the summary back;
+// SaveSummary always writes under the freshly expanded template.
+func (p *Processor) SummaryFilename() (string, error) {
+	if saved := p.loadSavedOutputs(); saved.Summary != "" {
+		if _, err := os.Stat(filepath.Join(p.meetingDir, saved.Summary)); err == nil {
+			return saved.Summary, nil
+		}
+	}
+	return p.GenerateOutputFilename()
+}
+
+// plainFilename reports whether name is empty or a filename without directories.
+func plainFilename(name string) bool {
+	return name == "" || (name == filepath.Base(name) && name != "." && name != "..")
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/bashfulrobot/meetsum/config"
 	"github.com/bashfulrobot/meetsum/internal/ai"
+	"github.com/bashfulrobot/meetsum/internal/calendar"
 	"github.com/bitfield/script"
 	"github.com/charmbracelet/log"
 )
@@ -21,7 +22,12 @@ type Processor struct {
 	logger         *log.Logger
 	userName       string
 	meetingDir     string
+	meetingType    string
+	recordingURL   string
 	transcriptPath string
+	invite         *calendar.Invite
+	invitePath     string
+	inviteLoaded   bool
 }
 
 // GeneratedSummaryOutput captures both cleaned and raw AI output.
@@ -46,6 +52,7 @@ func (p *Processor) SetUserName(name string) {
 // SetMeetingDir sets the meeting directory
 func (p *Processor) SetMeetingDir(dir string) {
 	p.meetingDir = dir
+	p.invite, p.invitePath, p.inviteLoaded = nil, "", false
 }
 
 // FindTranscriptFile resolves transcript source with the 0/1/many .txt contract.
@@ -62,8 +69,8 @@ func (p *Processor) ValidateRequiredFiles() error {
 	}
 	p.transcriptPath = transcriptPath
 
-	// Check instructions file
-	instructionsPath := p.config.GetInstructionsPath()
+	// Check instructions file for the meeting type
+	instructionsPath := p.instructionsPath()
 	if _, err := os.Stat(instructionsPath); os.IsNotExist(err) {
 		return fmt.Errorf("instructions file not found at %s", instructionsPath)
 	}
@@ -78,12 +85,15 @@ func (p *Processor) GetOptionalFiles() []string {
 	if _, err := os.Stat(povPath); err == nil {
 		files = append(files, "📝 pov-input.md")
 	}
+	if _, invitePath := p.Invite(); invitePath != "" {
+		files = append(files, "📅 "+filepath.Base(invitePath))
+	}
 	return files
 }
 
 // LoadInstructions reads the LLM instructions file
 func (p *Processor) LoadInstructions() (string, error) {
-	instructionsPath := p.config.GetInstructionsPath()
+	instructionsPath := p.instructionsPath()
 	content, err := script.File(instructionsPath).String()
 	if err != nil {
 		return "", fmt.Errorf("failed to load instructions: %w", err)
@@ -108,27 +118,40 @@ func (p *Processor) TranscriptPath() string {
 	return p.transcriptPath
 }
 
-// LoadWritingSkill loads the best available writing skill (writing-style > humanizer > none).
-// Returns the skill content and the skill name used, or empty strings if none found.
+// LoadWritingSkill loads the best available writing skill (meeting type's
+// writing_skill > writing-style > humanizer > none). Returns the skill content
+// and the skill name used, or empty strings if none found.
 func (p *Processor) LoadWritingSkill() (string, string) {
 	skillPath := p.config.GetWritingSkillPath()
+	skillName := "humanizer"
+	if strings.Contains(skillPath, "writing-style") {
+		skillName = "writing-style"
+	}
+
+	// A meeting type's own skill wins when its file exists
+	if typeSkill := p.config.GetWritingSkillPathForType(p.MeetingType()); typeSkill != "" {
+		if _, err := os.Stat(typeSkill); err == nil {
+			skillPath = typeSkill
+			skillName = strings.TrimSuffix(filepath.Base(typeSkill), filepath.Ext(typeSkill))
+		} else if p.logger != nil {
+			p.logger.Warn("meeting type writing skill not found; using the default skill", "path", typeSkill)
+		}
+	}
 	if skillPath == "" {
 		return "", ""
 	}
 
 	content, err := script.File(skillPath).String()
 	if err != nil {
-		p.logger.Warn("failed to load writing skill", "path", skillPath, "error", err)
+		if p.logger != nil {
+			p.logger.Warn("failed to load writing skill", "path", skillPath, "error", err)
+		}
 		return "", ""
 	}
 
-	// Determine which skill was loaded based on path
-	skillName := "humanizer"
-	if strings.Contains(skillPath, "writing-style") {
-		skillName = "writing-style"
+	if p.logger != nil {
+		p.logger.Info("loaded writing skill", "skill", skillName, "path", skillPath)
 	}
-
-	p.logger.Info("loaded writing skill", "skill", skillName, "path", skillPath)
 	return strings.TrimSpace(content), skillName
 }
 
@@ -147,9 +170,58 @@ func (p *Processor) LoadContext() (string, error) {
 	return fmt.Sprintf("CONTEXT GUIDE:\n%s", content), nil
 }
 
+// attendeesFile is the optional per-meeting attendee list, one name per line.
+const attendeesFile = "attendees.txt"
+
+// LoadAttendees reads the optional attendees.txt file in the meeting directory.
+// Blank lines and list markers are ignored. Falls back to the calendar invite
+// attendees when the file is absent, and returns nil when neither exists.
+func (p *Processor) LoadAttendees() []string {
+	content, err := os.ReadFile(filepath.Join(p.meetingDir, attendeesFile))
+	if err != nil {
+		if invite, _ := p.Invite(); invite != nil {
+			return inviteAttendees(invite)
+		}
+		return nil
+	}
+
+	var attendees []string
+	for _, line := range strings.Split(string(content), "\n") {
+		name := strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(line), "-*•"))
+		if name != "" {
+			attendees = append(attendees, name)
+		}
+	}
+	return attendees
+}
+
 // ExtractCustomerName extracts customer name from the meeting directory path.
-// Returns (proper-case name, UPPER-CASE name).
+// Meetings outside the customer root may take it from the calendar invite
+// instead. Returns (proper-case name, UPPER-CASE name).
 func (p *Processor) ExtractCustomerName() (string, string) {
+	customerNameRaw := p.customerFolderName()
+	if p.underCustomerRoot() {
+		return customerNameRaw, strings.ToUpper(customerNameRaw)
+	}
+
+	if invite, _ := p.Invite(); invite != nil {
+		if name := inviteCustomerName(invite, customerNameRaw, p.config.GetInternalDomains()); name != "" {
+			customerNameRaw = name
+		}
+	}
+
+	return customerNameRaw, strings.ToUpper(customerNameRaw)
+}
+
+// underCustomerRoot reports whether the meeting directory sits in a customer
+// folder: below /Customers/ or below paths.file_browser_root_dir.
+func (p *Processor) underCustomerRoot() bool {
+	return strings.Contains(p.meetingDir, "/Customers/") || p.config.UnderCustomerRoot(p.meetingDir)
+}
+
+// customerFolderName returns the customer folder of the meeting directory: the
+// folder below /Customers/, or else the parent directory name.
+func (p *Processor) customerFolderName() string {
 	// Extract customer name from path like /home/dustin/Documents/Kong/Customers/CustomerName/date
 	customerNameRaw := ""
 	if strings.Contains(p.meetingDir, "/Customers/") {
@@ -167,12 +239,19 @@ func (p *Processor) ExtractCustomerName() (string, string) {
 		parentDir := filepath.Dir(p.meetingDir)
 		customerNameRaw = filepath.Base(parentDir)
 	}
-
-	return customerNameRaw, strings.ToUpper(customerNameRaw)
+	return customerNameRaw
 }
 
-// ExtractDateFromPath extracts date from folder path like "2025-09-24"
+// ExtractDateFromPath returns the meeting date from the calendar invite, or
+// from a folder in the path like "2025-09-24". Recurring invites are skipped
+// because their start date is the first occurrence.
 func (p *Processor) ExtractDateFromPath() string {
+	if invite, _ := p.Invite(); invite != nil {
+		if date := invite.Date(); date != "" {
+			return date
+		}
+	}
+
 	// Look for date pattern YYYY-MM-DD in the path
 	parts := strings.Split(p.meetingDir, "/")
 	for _, part := range parts {
@@ -187,15 +266,62 @@ func (p *Processor) ExtractDateFromPath() string {
 	return "" // No date found in path
 }
 
-// GenerateOutputFilename creates the output filename
+// GenerateOutputFilename creates the output filename from the filename template
+// for the meeting type. Available variables: {date}, {customer}, {type},
+// {transcript} (transcript filename without extension) and {user}.
 func (p *Processor) GenerateOutputFilename() (string, error) {
+	template := p.config.GetFilenameTemplateForType(p.MeetingType())
+	filename := ExpandTemplate(template, p.TemplateVars())
+
+	if strings.ContainsAny(filename, `/\`) {
+		return "", fmt.Errorf("filename template %q must not contain directories", template)
+	}
+	if strings.TrimSpace(strings.TrimSuffix(filename, filepath.Ext(filename))) == "" {
+		return "", fmt.Errorf("filename template %q expanded to an empty filename", template)
+	}
+	if filepath.Ext(filename) == "" {
+		filename += ".md"
+	}
+	return filename, nil
+}
+
+// TemplateVars returns the placeholder values shared by the filename, email
+// subject and commit message templates.
+func (p *Processor) TemplateVars() map[string]string {
 	name, _ := p.ExtractCustomerName()
+	return map[string]string{
+		"date":       p.ExtractDateFromPath(),
+		"customer":   name,
+		"type":       p.MeetingType(),
+		"transcript": p.transcriptStem(),
+		"user":       p.userName,
+	}
+}
 
-	date := p.ExtractDateFromPath()
-	if date == "" {
-		return fmt.Sprintf("%s-cadence-call-summary.md", name), nil
+// SummaryPath returns the path SaveSummary writes the main summary to.
+func (p *Processor) SummaryPath() (string, error) {
+	filename, err := p.SummaryFilename()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(p.meetingDir, filename), nil
+}
+
+// LoadSavedSummary reads the main summary previously saved for the meeting directory.
+func (p *Processor) LoadSavedSummary() (string, string, error) {
+	summaryPath, err := p.SummaryPath()
+	if err != nil {
+		return "", "", err
+	}
+	if _, err := os.Stat(summaryPath); os.IsNotExist(err) {
+		return "", summaryPath, fmt.Errorf("no summary found at %s; run meetsum on this directory first", summaryPath)
+	}
+
+	content, err := script.File(summaryPath).String()
+	if err != nil {
+		return "", summaryPath, fmt.Errorf("failed to load summary: %w", err)
 	}
-	return fmt.Sprintf("%s-%s-cadence-call-summary.md", date, name), nil
+	return content, summaryPath, nil
 }
 
 // GenerateSummary processes the meeting and generates a cleaned summary.
@@ -209,31 +335,35 @@ func (p *Processor) GenerateSummary() (string, error) {
 
 // GenerateSummaryOutput processes the meeting and returns cleaned + raw output.
 func (p *Processor) GenerateSummaryOutput() (GeneratedSummaryOutput, error) {
-	// Load all required content
-	instructions, err := p.LoadInstructions()
-	if err != nil {
-		return GeneratedSummaryOutput{}, err
-	}
-
-	transcript, err := p.LoadTranscript()
+	prompt, err := p.BuildPrompt()
 	if err != nil {
 		return GeneratedSummaryOutput{}, err
 	}
 
-	context, err := p.LoadContext()
+	// Execute AI command with separate stdout/stderr capture
+	result, stderr, err := p.executeAICommand(prompt.Text)
 	if err != nil {
-		return GeneratedSummaryOutput{}, err
+		p.logCommandError(stderr, err)
+		return GeneratedSummaryOutput{}, fmt.Errorf("failed to generate summary: %w", err)
 	}
 
-	// Load optional writing skill (writing-style > humanizer > none)
-	writingSkill, skillName := p.LoadWritingSkill()
-	writingSkillBlock := ""
-	if writingSkill != "" {
-		writingSkillBlock = fmt.Sprintf(`WRITING STYLE INSTRUCTIONS (%s skill):
-Apply the following writing style to ALL paragraph content in the summary. This affects tone, word choice, and sentence structure for topic sections, highlights, and action items. Do not alter formatting rules or section structure — only the voice and style of the prose.
+	// Clean the AI output to extract only the markdown content
+	cleanedResult := p.cleanAIOutput(result)
+	return GeneratedSummaryOutput{
+		Cleaned: cleanedResult,
+		Raw:     result,
+	}, nil
+}
 
-%s`, skillName, writingSkill)
+// BuildPrompt assembles the summary prompt sent to the AI command, along with
+// the pieces it was built from.
+func (p *Processor) BuildPrompt() (Prompt, error) {
+	parts, err := p.loadPromptParts()
+	if err != nil {
+		return Prompt{}, err
 	}
+	instructions, extras, writingSkillBlock := parts.instructions, parts.extras, parts.writingSkill
+	transcript, context := parts.transcript, parts.context
 
 	// Extract date and customer info for the prompt
 	customerNameProper, customerNameUpper := p.ExtractCustomerName()
@@ -246,8 +376,14 @@ Apply the following writing style to ALL paragraph content in the summary. This
 
 	transcriptFile := filepath.Base(p.transcriptPath)
 
+	invite := parts.invite
+	inviteBlock := invite
+	if inviteBlock != "" {
+		inviteBlock = "\n" + inviteBlock + "\n"
+	}
+
 	// Prepare the prompt
-	prompt := fmt.Sprintf(`%s
+	text := fmt.Sprintf(`%s
 
 %s
 
@@ -255,7 +391,7 @@ Process the transcript in %s and generate a structured meeting summary following
 
 The meeting date should be: %s
 The customer name should be: %s (uppercase: %s)
-
+%s
 IMPORTANT OUTPUT INSTRUCTIONS:
 - Output ONLY the summary content directly with its Slack-compatible markdown formatting intact.
 - Do NOT wrap the output in triple-backtick code fences.
@@ -266,20 +402,65 @@ IMPORTANT OUTPUT INSTRUCTIONS:
 TRANSCRIPT:
 %s
 
-%s`, instructions, writingSkillBlock, transcriptFile, p.userName, titleDate, customerNameProper, customerNameUpper, transcript, context)
+%s`, instructions+extras, writingSkillBlock, transcriptFile, p.userName, titleDate, customerNameProper, customerNameUpper, inviteBlock, transcript, context)
 
-	// Execute AI command with separate stdout/stderr capture
-	result, stderr, err := p.executeAICommand(prompt)
+	return newPrompt(text, []PromptPart{
+		{Name: "instructions", Size: len(instructions)},
+		{Name: "profile extras", Size: len(extras)},
+		{Name: "writing skill", Size: len(writingSkillBlock)},
+		{Name: "meeting invite", Size: len(invite)},
+		{Name: "transcript", Size: len(transcript)},
+		{Name: "context", Size: len(context)},
+	}), nil
+}
+
+// promptParts are the inputs every summary prompt is built from, so a
+// regenerated section is written under the same instructions as the summary.
+type promptParts struct {
+	instructions string
+	extras       string
+	// writingSkill is the rendered writing style block, or "".
+	writingSkill string
+	invite       string
+	transcript   string
+	context      string
+}
+
+// loadPromptParts loads the instructions, profile extras, writing skill,
+// invite, transcript and context for the meeting.
+func (p *Processor) loadPromptParts() (promptParts, error) {
+	instructions, err := p.LoadInstructions()
 	if err != nil {
-		p.logCommandError(stderr, err)
-		return GeneratedSummaryOutput{}, fmt.Errorf("failed to generate summary: %w", err)
+		return promptParts{}, err
 	}
 
-	// Clean the AI output to extract only the markdown content
-	cleanedResult := p.cleanAIOutput(result)
-	return GeneratedSummaryOutput{
-		Cleaned: cleanedResult,
-		Raw:     result,
+	transcript, err := p.LoadTranscript()
+	if err != nil {
+		return promptParts{}, err
+	}
+
+	context, err := p.LoadContext()
+	if err != nil {
+		return promptParts{}, err
+	}
+
+	// Load optional writing skill (writing-style > humanizer > none)
+	writingSkill, skillName := p.LoadWritingSkill()
+	writingSkillBlock := ""
+	if writingSkill != "" {
+		writingSkillBlock = fmt.Sprintf(`WRITING STYLE INSTRUCTIONS (%s skill):
+Apply the following writing style to ALL paragraph content in the summary. This affects tone, word choice, and sentence structure for topic sections, highlights, and action items. Do not alter formatting rules or section structure — only the voice and style of the prose.
+
+%s`, skillName, writingSkill)
+	}
+
+	return promptParts{
+		instructions: instructions,
+		extras:       p.promptExtrasBlock(),
+		writingSkill: writingSkillBlock,
+		invite:       p.inviteBlock(),
+		transcript:   transcript,
+		context:      context,
 	}, nil
 }
 
@@ -461,14 +642,23 @@ func (p *Processor) SaveRawOutputDiagnostics(rawOutput string) (string, error) {
 
 // writeContentFile writes content to path, ensuring a trailing newline.
 func writeContentFile(content, path string) error {
+	_, err := script.Echo(SavedContent(content)).WriteFile(path)
+	return err
+}
+
+// SavedContent returns content as the save functions write it, ending with a
+// newline. Lint it rather than the raw content so the trailing-newline rule
+// matches the file.
+func SavedContent(content string) string {
 	if !strings.HasSuffix(content, "\n") {
 		content += "\n"
 	}
-	_, err := script.Echo(content).WriteFile(path)
-	return err
+	return content
 }
 
-// SaveSummary saves the generated summary to a file
+// SaveSummary saves the generated summary under the filename template as it
+// expands now and records that filename, so later commands find it even when
+// the template would expand differently.
 func (p *Processor) SaveSummary(content string) (string, error) {
 	filename, err := p.GenerateOutputFilename()
 	if err != nil {
@@ -480,9 +670,23 @@ func (p *Processor) SaveSummary(content string) (string, error) {
 		return "", fmt.Errorf("failed to save summary: %w", err)
 	}
 
+	saved := p.loadSavedOutputs()
+	if saved.Summary != filename {
+		saved = savedOutputs{Summary: filename}
+	}
+	p.recordOutputs(saved)
 	return outputPath, nil
 }
 
+// datedTranscriptRe matches transcripts already renamed by RenameTranscriptFile.
+var datedTranscriptRe = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}-transcript\.txt$`)
+
+// IsDatedTranscript reports whether a transcript filename is already in the
+// dated YYYY-MM-DD-transcript.txt form.
+func IsDatedTranscript(filename string) bool {
+	return datedTranscriptRe.MatchString(filename)
+}
+
 // RenameTranscriptFile renames the selected transcript file to a dated format based on the folder date.
 // Returns the new filename if renamed, empty string if skipped, or error if failed.
 // Skips rename if: already dated, no date in folder path, or transcriptPath not set.
@@ -494,8 +698,7 @@ func (p *Processor) RenameTranscriptFile() (string, error) {
 	filename := filepath.Base(p.transcriptPath)
 
 	// Check if already a dated transcript (skip rename)
-	datePattern := regexp.MustCompile(`^\d{4}-\d{2}-\d{2}-transcript\.txt$`)
-	if datePattern.MatchString(filename) {
+	if IsDatedTranscript(filename) {
 		return "", nil // Already dated, nothing to do
 	}
 
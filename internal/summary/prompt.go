@@ -0,0 +1,49 @@
+package summary
+
+// bytesPerToken is the rough bytes-per-token ratio of current LLM tokenizers
+// for English text. Estimates are for sizing, not billing.
+const bytesPerToken = 4
+
+// PromptPart is the size in bytes of one piece of the summary prompt.
+type PromptPart struct {
+	Name string `json:"name"`
+	Size int    `json:"bytes"`
+	// Tokens is an estimate; see EstimateTokens.
+	Tokens int `json:"estimated_tokens"`
+}
+
+// Prompt is the exact text sent to the AI command and how it breaks down.
+// The "framing" part covers the fixed wording, names and dates around the
+// other parts.
+type Prompt struct {
+	Text   string       `json:"-"`
+	Parts  []PromptPart `json:"parts"`
+	Size   int          `json:"bytes"`
+	Tokens int          `json:"estimated_tokens"`
+}
+
+// EstimateTokens approximates the token count of text.
+func EstimateTokens(text string) int {
+	return estimateTokens(len(text))
+}
+
+func estimateTokens(size int) int {
+	return (size + bytesPerToken - 1) / bytesPerToken
+}
+
+// newPrompt fills in token estimates and the framing part for text.
+func newPrompt(text string, parts []PromptPart) Prompt {
+	framing := len(text)
+	for i := range parts {
+		parts[i].Tokens = estimateTokens(parts[i].Size)
+		framing -= parts[i].Size
+	}
+	parts = append(parts, PromptPart{Name: "framing", Size: framing, Tokens: estimateTokens(framing)})
+
+	return Prompt{
+		Text:   text,
+		Parts:  parts,
+		Size:   len(text),
+		Tokens: EstimateTokens(text),
+	}
+}
@@ -0,0 +1,159 @@
+package summary
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// RecordingPlaceholder is the link target the LLM instructions leave in the
+// MEETING RECORDING section for a human to replace.
+const RecordingPlaceholder = "PLACEHOLDER_URL"
+
+// SetRecordingURL sets an explicit recording link, overriding the metadata
+// file and any .url/.webloc shortcut in the meeting directory.
+func (p *Processor) SetRecordingURL(recordingURL string) {
+	p.recordingURL = strings.TrimSpace(recordingURL)
+}
+
+// RecordingURL resolves the recording link for the meeting. Priority:
+// SetRecordingURL > recording_url in the metadata file > .url/.webloc file.
+// Returns the link and where it came from, or empty strings when none is found.
+func (p *Processor) RecordingURL() (string, string, error) {
+	if p.recordingURL != "" {
+		if err := ValidateRecordingURL(p.recordingURL); err != nil {
+			return "", "", err
+		}
+		return p.recordingURL, "--recording-url", nil
+	}
+
+	metadataPath := p.config.GetMetadataPath(p.meetingDir)
+	if _, err := os.Stat(metadataPath); err == nil {
+		metadata := viper.New()
+		metadata.SetConfigFile(metadataPath)
+		metadata.SetConfigType("yaml")
+		if err := metadata.ReadInConfig(); err != nil {
+			return "", "", fmt.Errorf("failed to read %s: %w", filepath.Base(metadataPath), err)
+		}
+		if link := strings.TrimSpace(metadata.GetString("recording_url")); link != "" {
+			if err := ValidateRecordingURL(link); err != nil {
+				return "", "", fmt.Errorf("%s: %w", filepath.Base(metadataPath), err)
+			}
+			return link, filepath.Base(metadataPath), nil
+		}
+	}
+
+	return findShortcutURL(p.meetingDir)
+}
+
+// ValidateRecordingURL checks that a recording link is an absolute http(s) URL.
+func ValidateRecordingURL(link string) error {
+	parsed, err := url.Parse(link)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return fmt.Errorf("invalid recording URL %q: must be an http(s) link", link)
+	}
+	return nil
+}
+
+// FillRecordingPlaceholder replaces every recording placeholder with the link.
+func FillRecordingPlaceholder(content, link string) string {
+	if link == "" {
+		return content
+	}
+	return strings.ReplaceAll(content, RecordingPlaceholder, link)
+}
+
+// HasRecordingPlaceholder reports whether content still carries the placeholder.
+func HasRecordingPlaceholder(content string) bool {
+	return strings.Contains(content, RecordingPlaceholder)
+}
+
+// findShortcutURL returns the link from the first .url (Windows Internet
+// Shortcut) or .webloc (macOS) file in the meeting directory.
+func findShortcutURL(meetingDir string) (string, string, error) {
+	entries, err := os.ReadDir(meetingDir)
+	if err != nil {
+		return "", "", nil
+	}
+
+	var names []string
+	for _, entry := range entries {
+		ext := strings.ToLower(filepath.Ext(entry.Name()))
+		if !entry.IsDir() && (ext == ".url" || ext == ".webloc") {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		content, err := os.ReadFile(filepath.Join(meetingDir, name))
+		if err != nil {
+			continue
+		}
+
+		var link string
+		if strings.EqualFold(filepath.Ext(name), ".webloc") {
+			link = parseWebloc(content)
+		} else {
+			link = parseInternetShortcut(string(content))
+		}
+		if link == "" {
+			continue
+		}
+		if err := ValidateRecordingURL(link); err != nil {
+			return "", "", fmt.Errorf("%s: %w", name, err)
+		}
+		return link, name, nil
+	}
+	return "", "", nil
+}
+
+// parseInternetShortcut reads the URL= line of a .url file.
+func parseInternetShortcut(content string) string {
+	for _, line := range strings.Split(content, "\n") {
+		key, value, found := strings.Cut(strings.TrimSpace(line), "=")
+		if found && strings.EqualFold(strings.TrimSpace(key), "URL") {
+			return strings.TrimSpace(value)
+		}
+	}
+	return ""
+}
+
+// parseWebloc reads the string following the URL key of an XML .webloc plist.
+func parseWebloc(content []byte) string {
+	decoder := xml.NewDecoder(strings.NewReader(string(content)))
+	var lastKey string
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return ""
+		}
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+
+		var text string
+		switch start.Name.Local {
+		case "key":
+			if err := decoder.DecodeElement(&text, &start); err != nil {
+				return ""
+			}
+			lastKey = text
+		case "string":
+			if err := decoder.DecodeElement(&text, &start); err != nil {
+				return ""
+			}
+			if lastKey == "URL" {
+				return strings.TrimSpace(text)
+			}
+			lastKey = ""
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package summary
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRecordingURL(t *testing.T) {
+	webloc := `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>URL</key>
+	<string>https://drive.example.com/recording</string>
+</dict>
+</plist>
+`
+
+	cases := []struct {
+		name   string
+		files  map[string]string
+		expect string
+		source string
+	}{
+		{"none", nil, "", ""},
+		{"internet shortcut", map[string]string{"Recording.url": "[InternetShortcut]\r\nURL=https://zoom.example.com/rec/1\r\n"}, "https://zoom.example.com/rec/1", "Recording.url"},
+		{"webloc", map[string]string{"Recording.webloc": webloc}, "https://drive.example.com/recording", "Recording.webloc"},
+		{
+			"metadata wins over shortcut",
+			map[string]string{
+				"meeting.yaml":  "recording_url: https://meta.example.com/rec\n",
+				"Recording.url": "[InternetShortcut]\nURL=https://zoom.example.com/rec/1\n",
+			},
+			"https://meta.example.com/rec",
+			"meeting.yaml",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			meetingDir := t.TempDir()
+			for name, content := range tc.files {
+				if err := os.WriteFile(filepath.Join(meetingDir, name), []byte(content), 0644); err != nil {
+					t.Fatalf("failed to write %s: %v", name, err)
+				}
+			}
+
+			link, source, err := newTestProcessor(t, meetingDir).RecordingURL()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if link != tc.expect || source != tc.source {
+				t.Errorf("expected %q from %q, got %q from %q", tc.expect, tc.source, link, source)
+			}
+		})
+	}
+
+	t.Run("invalid metadata link", func(t *testing.T) {
+		meetingDir := t.TempDir()
+		if err := os.WriteFile(filepath.Join(meetingDir, "meeting.yaml"), []byte("recording_url: ftp://example.com\n"), 0644); err != nil {
+			t.Fatalf("failed to write metadata: %v", err)
+		}
+		if _, _, err := newTestProcessor(t, meetingDir).RecordingURL(); err == nil {
+			t.Fatal("expected invalid URL error")
+		}
+	})
+}
+
+func TestFillRecordingPlaceholder(t *testing.T) {
+	filled := FillRecordingPlaceholder(testSummaryAllSections, "https://zoom.example.com/rec/1")
+	if HasRecordingPlaceholder(filled) {
+		t.Fatal("expected placeholder to be replaced")
+	}
+	if ParseSections(filled)["meeting-recording"] == ParseSections(testSummaryAllSections)["meeting-recording"] {
+		t.Fatal("expected meeting recording section to change")
+	}
+	if FillRecordingPlaceholder(testSummaryAllSections, "") != testSummaryAllSections {
+		t.Fatal("expected empty link to leave content unchanged")
+	}
+}
@@ -0,0 +1,135 @@
+package summary
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// NormalizeSectionKey converts a user-supplied section name such as
+// "Action Items", "action_items" or "topic:roadmap" to a ParseSections key.
+func NormalizeSectionKey(name string) string {
+	name = strings.TrimSpace(name)
+	if topic, ok := cutPrefixFold(name, "topic:"); ok {
+		return "topic:" + strings.ToUpper(strings.TrimSpace(topic))
+	}
+	name = strings.ToLower(name)
+	name = strings.NewReplacer("_", "-", " ", "-").Replace(name)
+	return strings.Join(strings.FieldsFunc(name, func(r rune) bool { return r == '-' }), "-")
+}
+
+// SectionKeys returns the section keys of content in sorted order.
+func SectionKeys(content string) []string {
+	var keys []string
+	for key := range ParseSections(content) {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// ReplaceSection swaps the section with the given key for replacement, which
+// must start with the section header. Every other section, and the spacing
+// between sections, is left exactly as it was.
+func ReplaceSection(content, key, replacement string) (string, error) {
+	replacement = strings.TrimSpace(replacement)
+	if sectionHeaderKey(firstLine(replacement)) != key {
+		return "", fmt.Errorf("replacement for section %q must start with its header", key)
+	}
+
+	sections := splitSections(content)
+	found := false
+	var output strings.Builder
+	for i, section := range sections {
+		if i > 0 {
+			output.WriteString("\n")
+		}
+		if section.key != key || found {
+			output.WriteString(section.text)
+			continue
+		}
+		found = true
+		// Keep the blank lines that separated this section from the next
+		trimmed := strings.TrimRight(section.text, " \t\n")
+		output.WriteString(replacement)
+		output.WriteString(section.text[len(trimmed):])
+	}
+	if !found {
+		return "", fmt.Errorf("summary has no %q section", key)
+	}
+	return output.String(), nil
+}
+
+// RegenerateSection asks the AI to rewrite one section of an existing summary,
+// using the summary and transcript as context. Returns the new section text,
+// starting with its header.
+func (p *Processor) RegenerateSection(summaryContent, key string) (string, error) {
+	current, ok := ParseSections(summaryContent)[key]
+	if !ok {
+		return "", fmt.Errorf("summary has no %q section (available: %s)", key, strings.Join(SectionKeys(summaryContent), ", "))
+	}
+	header := strings.TrimSpace(firstLine(current))
+
+	// Same instructions, writing style, invite and context as the full summary
+	parts, err := p.loadPromptParts()
+	if err != nil {
+		return "", err
+	}
+	inviteBlock := parts.invite
+	if inviteBlock != "" {
+		inviteBlock = "\n" + inviteBlock + "\n"
+	}
+
+	prompt := fmt.Sprintf(`%s
+
+%s
+
+The summary below was generated from the transcript using the instructions above. Rewrite ONLY its %s section so that it is accurate and complete according to the transcript and the instructions. Write from %s's first-person perspective.
+%s
+IMPORTANT OUTPUT INSTRUCTIONS:
+- Output ONLY the rewritten section, starting with the exact header line %s.
+- Keep the Slack-compatible markdown formatting used by the rest of the summary.
+- Do NOT output any other section, code fences, preamble or commentary.
+- Do NOT attempt to save, write, or create any files.
+
+CURRENT SUMMARY:
+%s
+
+TRANSCRIPT:
+%s
+
+%s`, parts.instructions+parts.extras, parts.writingSkill, header, p.userName, inviteBlock, header, summaryContent, parts.transcript, parts.context)
+
+	result, stderr, err := p.executeAICommand(prompt)
+	if err != nil {
+		p.logCommandError(stderr, err)
+		return "", fmt.Errorf("failed to regenerate section: %w", err)
+	}
+
+	cleaned := strings.TrimSpace(p.cleanAIOutput(result))
+	if cleaned == "" {
+		return "", fmt.Errorf("regenerated %s section is empty after cleaning", key)
+	}
+
+	// Providers sometimes drop the header or echo neighbouring sections
+	if sectionHeaderKey(firstLine(cleaned)) == "" {
+		cleaned = header + "\n\n" + cleaned
+	}
+	section, ok := ParseSections(cleaned)[key]
+	if !ok || strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(section), header)) == "" {
+		return "", fmt.Errorf("AI output did not contain a usable %s section", key)
+	}
+	return section, nil
+}
+
+func firstLine(text string) string {
+	line, _, _ := strings.Cut(strings.TrimLeft(text, "\n"), "\n")
+	return line
+}
+
+func cutPrefixFold(s, prefix string) (string, bool) {
+	if len(s) >= len(prefix) && strings.EqualFold(s[:len(prefix)], prefix) {
+		return s[len(prefix):], true
+	}
+	return s, false
+}
@@ -0,0 +1,54 @@
+package summary
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNormalizeSectionKey(t *testing.T) {
+	tests := map[string]string{
+		"action-items":          "action-items",
+		"Action Items":          "action-items",
+		"ACTION_ITEMS":          "action-items",
+		" highlights ":          "highlights",
+		"topic:product roadmap": "topic:PRODUCT ROADMAP",
+		"Topic:Roadmap":         "topic:ROADMAP",
+	}
+	for input, want := range tests {
+		if got := NormalizeSectionKey(input); got != want {
+			t.Errorf("NormalizeSectionKey(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestReplaceSection(t *testing.T) {
+	replacement := "*ACTION ITEMS*\n\n- Jane Smith: Send the revised proposal by Friday"
+	updated, err := ReplaceSection(testSummaryAllSections, "action-items", replacement)
+	if err != nil {
+		t.Fatalf("replace failed: %v", err)
+	}
+
+	before := ParseSections(testSummaryAllSections)
+	after := ParseSections(updated)
+	if after["action-items"] != replacement {
+		t.Errorf("expected the new action items, got:\n%s", after["action-items"])
+	}
+	for key, content := range before {
+		if key != "action-items" && after[key] != content {
+			t.Errorf("section %q changed:\n%s\n---\n%s", key, content, after[key])
+		}
+	}
+
+	// Spacing around the replaced section is preserved
+	original := strings.Replace(testSummaryAllSections, before["action-items"], replacement, 1)
+	if updated != original {
+		t.Errorf("unexpected spacing after replace:\n%s", updated)
+	}
+
+	if _, err := ReplaceSection(testSummaryNoRisks, "risks", "*RISKS*\n\n- None"); err == nil {
+		t.Error("expected an error for a missing section")
+	}
+	if _, err := ReplaceSection(testSummaryAllSections, "action-items", "- no header"); err == nil {
+		t.Error("expected an error for a replacement without a header")
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strings"
 )
 
@@ -20,51 +21,78 @@ var (
 // Italic-only headers (_TOPIC_) map to "topic:NAME" keys.
 func ParseSections(content string) map[string]string {
 	sections := make(map[string]string)
-	lines := strings.Split(content, "\n")
+	for _, section := range splitSections(content) {
+		if section.key != "" {
+			sections[section.key] = strings.TrimRight(section.text, " \t\n")
+		}
+	}
+	return sections
+}
+
+// rawSection is one header-delimited chunk of a summary, in document order.
+// The chunk before the first header has an empty key. Text keeps its trailing
+// blank lines so the chunks concatenate back to the original content.
+type rawSection struct {
+	key  string
+	text string
+}
 
-	var currentKey string
+// splitSections splits content at section headers without dropping any text.
+func splitSections(content string) []rawSection {
+	var sections []rawSection
+	current := rawSection{}
 	var currentLines []string
 
 	flush := func() {
-		if currentKey != "" {
-			text := strings.Join(currentLines, "\n")
-			sections[currentKey] = strings.TrimRight(text, " \t\n")
+		if current.key != "" || len(currentLines) > 0 {
+			current.text = strings.Join(currentLines, "\n")
+			sections = append(sections, current)
 		}
 	}
 
-	for _, line := range lines {
-		trimmed := strings.TrimSpace(line)
-
-		if boldItalicHeaderRe.MatchString(trimmed) {
+	for _, line := range strings.Split(content, "\n") {
+		if key := sectionHeaderKey(line); key != "" {
 			flush()
-			currentKey = "title"
+			current = rawSection{key: key}
 			currentLines = []string{line}
 			continue
 		}
+		currentLines = append(currentLines, line)
+	}
 
-		if boldHeaderRe.MatchString(trimmed) {
-			flush()
-			name := boldHeaderRe.FindStringSubmatch(trimmed)[1]
-			currentKey = strings.ToLower(strings.ReplaceAll(name, " ", "-"))
-			currentLines = []string{line}
-			continue
-		}
+	flush()
+	return sections
+}
 
-		if italicHeaderRe.MatchString(trimmed) {
-			flush()
-			name := italicHeaderRe.FindStringSubmatch(trimmed)[1]
-			currentKey = "topic:" + name
-			currentLines = []string{line}
-			continue
-		}
+// sectionHeaderKey returns the section key when line is a section header.
+func sectionHeaderKey(line string) string {
+	trimmed := strings.TrimSpace(line)
+	switch {
+	case boldItalicHeaderRe.MatchString(trimmed):
+		return "title"
+	case boldHeaderRe.MatchString(trimmed):
+		name := boldHeaderRe.FindStringSubmatch(trimmed)[1]
+		return strings.ToLower(strings.ReplaceAll(name, " ", "-"))
+	case italicHeaderRe.MatchString(trimmed):
+		return "topic:" + italicHeaderRe.FindStringSubmatch(trimmed)[1]
+	default:
+		return ""
+	}
+}
 
-		if currentKey != "" {
-			currentLines = append(currentLines, line)
+// SectionBullets returns the text of each "-" or "•" bullet in a parsed section.
+func SectionBullets(section string) []string {
+	var bullets []string
+	for _, line := range strings.Split(section, "\n") {
+		trimmed := strings.TrimSpace(line)
+		for _, marker := range []string{"- ", "• "} {
+			if text, found := strings.CutPrefix(trimmed, marker); found && strings.TrimSpace(text) != "" {
+				bullets = append(bullets, strings.TrimSpace(text))
+				break
+			}
 		}
 	}
-
-	flush()
-	return sections
+	return bullets
 }
 
 // slackSectionOrder defines the fixed section key order for Slack mini summaries.
@@ -79,9 +107,26 @@ var slackSectionOrder = []string{
 // BuildSlackSummary reassembles parsed sections in the fixed Slack order and
 // appends a Full Meeting Summary section with a placeholder link.
 func BuildSlackSummary(sections map[string]string) string {
-	var parts []string
+	return BuildSlackSummaryInOrder(sections, nil)
+}
+
+// BuildSlackSummaryInOrder is BuildSlackSummary with the sections listed in
+// order instead of the fixed Slack order. Names are normalized like section
+// names on the command line, and the title always comes first. An empty order
+// uses the fixed Slack order.
+func BuildSlackSummaryInOrder(sections map[string]string, order []string) string {
+	keys := slackSectionOrder
+	if len(order) > 0 {
+		keys = []string{"title"}
+		for _, name := range order {
+			if key := NormalizeSectionKey(name); key != "" && !slices.Contains(keys, key) {
+				keys = append(keys, key)
+			}
+		}
+	}
 
-	for _, key := range slackSectionOrder {
+	var parts []string
+	for _, key := range keys {
 		if content, ok := sections[key]; ok {
 			parts = append(parts, content)
 		}
@@ -93,18 +138,31 @@ func BuildSlackSummary(sections map[string]string) string {
 }
 
 // GenerateSlackOutputFilename creates the Slack output filename by inserting
-// -slack before the .md extension of the main summary filename.
+// -slack before the .md extension of the main summary filename. A filename
+// recorded for the same summary is returned as is.
 func (p *Processor) GenerateSlackOutputFilename() (string, error) {
-	mainFilename, err := p.GenerateOutputFilename()
+	mainFilename, err := p.SummaryFilename()
 	if err != nil {
 		return "", err
 	}
+	if saved := p.loadSavedOutputs(); saved.Summary == mainFilename && saved.Slack != "" {
+		return saved.Slack, nil
+	}
 
 	ext := filepath.Ext(mainFilename)
 	base := strings.TrimSuffix(mainFilename, ext)
 	return base + "-slack" + ext, nil
 }
 
+// SlackSummaryPath returns the path SaveSlackSummary writes to.
+func (p *Processor) SlackSummaryPath() (string, error) {
+	filename, err := p.GenerateSlackOutputFilename()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(p.meetingDir, filename), nil
+}
+
 // SaveSlackSummary writes the Slack mini summary to the meeting directory.
 func (p *Processor) SaveSlackSummary(content string) (string, error) {
 	filename, err := p.GenerateSlackOutputFilename()
@@ -117,5 +175,8 @@ func (p *Processor) SaveSlackSummary(content string) (string, error) {
 		return "", fmt.Errorf("failed to save slack summary: %w", err)
 	}
 
+	if mainFilename, err := p.SummaryFilename(); err == nil {
+		p.recordOutputs(savedOutputs{Summary: mainFilename, Slack: filename})
+	}
 	return outputPath, nil
 }
@@ -176,6 +176,22 @@ func TestBuildSlackSummary(t *testing.T) {
 		}
 	})
 
+	t.Run("custom order", func(t *testing.T) {
+		sections := ParseSections(testSummaryAllSections)
+		result := BuildSlackSummaryInOrder(sections, []string{"Action Items", "topic:product roadmap", "highlights"})
+
+		titleIdx := strings.Index(result, "*_2026-02-23 ACME CADENCE CALL SUMMARY_*")
+		actionIdx := strings.Index(result, "*ACTION ITEMS*")
+		topicIdx := strings.Index(result, "_PRODUCT ROADMAP_")
+		highlightsIdx := strings.Index(result, "*HIGHLIGHTS*")
+		if titleIdx != 0 || !(actionIdx > titleIdx && topicIdx > actionIdx && highlightsIdx > topicIdx) {
+			t.Errorf("sections out of order:\n%s", result)
+		}
+		if strings.Contains(result, "*RISKS*") || !strings.Contains(result, "*FULL MEETING SUMMARY*") {
+			t.Errorf("expected only the listed sections plus the full summary link:\n%s", result)
+		}
+	})
+
 	t.Run("risks omitted when absent", func(t *testing.T) {
 		sections := ParseSections(testSummaryNoRisks)
 		result := BuildSlackSummary(sections)
@@ -0,0 +1,50 @@
+package summary
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// StateDirName is the per-meeting directory meetsum uses for sidecar state
+// such as delivery records. It is hidden so it stays out of the file browser.
+const StateDirName = ".meetsum"
+
+// StateDir returns the sidecar state directory for a meeting directory.
+func StateDir(meetingDir string) string {
+	return filepath.Join(meetingDir, StateDirName)
+}
+
+// WriteStateFile stores v as indented JSON in the meeting's state directory.
+func WriteStateFile(meetingDir, name string, v any) (string, error) {
+	dir := StateDir(meetingDir)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create state directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to encode %s: %w", name, err)
+	}
+
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, append(data, '\n'), 0644); err != nil {
+		return "", fmt.Errorf("failed to write %s: %w", name, err)
+	}
+
+	return path, nil
+}
+
+// ReadStateFile loads JSON from the meeting's state directory into v.
+// A missing file is reported with an error satisfying os.IsNotExist.
+func ReadStateFile(meetingDir, name string, v any) error {
+	data, err := os.ReadFile(filepath.Join(StateDir(meetingDir), name))
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("failed to decode %s: %w", name, err)
+	}
+	return nil
+}
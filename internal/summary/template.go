@@ -0,0 +1,59 @@
+package summary
+
+import (
+	"regexp"
+	"strings"
+)
+
+// emptyTemplateValue marks placeholders that expanded to nothing so the
+// separator next to them can be dropped.
+const emptyTemplateValue = "\x00"
+
+var (
+	templateVarRe    = regexp.MustCompile(`\{([a-z_]+)\}`)
+	unsafeFilenameRe = regexp.MustCompile(`[/\\:*?"<>|]+`)
+	emptyBeforeRe    = regexp.MustCompile(`[ _-]?\x00`)
+	emptyAfterRe     = regexp.MustCompile(`\x00[ _-]?`)
+)
+
+// ExpandTemplate replaces {name} placeholders with values from vars. Values are
+// made filename-safe and placeholders with empty or unknown values are removed
+// together with one adjacent separator, so "{date}-{customer}.md" becomes
+// "Acme.md" when no date is known. Slashes in the template itself are kept so
+// templates may place files in sub-folders.
+func ExpandTemplate(tmpl string, vars map[string]string) string {
+	return expandPlaceholders(tmpl, vars, func(value string) string {
+		return unsafeFilenameRe.ReplaceAllString(value, "-")
+	})
+}
+
+// ExpandText expands a one-line text template such as an email subject or a
+// commit message with the same placeholders and the same handling of empty or
+// unknown ones as ExpandTemplate. Values are kept as they are, parentheses
+// left empty are dropped and whitespace is collapsed.
+func ExpandText(tmpl string, vars map[string]string) string {
+	expanded := expandPlaceholders(tmpl, vars, func(value string) string { return value })
+	return strings.Join(strings.Fields(strings.ReplaceAll(expanded, "()", "")), " ")
+}
+
+// expandPlaceholders replaces placeholders with their values passed through
+// clean, dropping empty ones together with one adjacent separator.
+func expandPlaceholders(tmpl string, vars map[string]string, clean func(string) string) string {
+	expanded := templateVarRe.ReplaceAllStringFunc(tmpl, func(match string) string {
+		value := strings.TrimSpace(vars[match[1:len(match)-1]])
+		if value == "" {
+			return emptyTemplateValue
+		}
+		return clean(value)
+	})
+
+	segments := strings.Split(expanded, "/")
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, emptyTemplateValue) {
+			segment = emptyAfterRe.ReplaceAllString(segment, "")
+		}
+		segment = emptyBeforeRe.ReplaceAllString(segment, "")
+		segments[i] = strings.ReplaceAll(segment, emptyTemplateValue, "")
+	}
+	return strings.Join(segments, "/")
+}
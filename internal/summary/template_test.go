@@ -0,0 +1,67 @@
+package summary
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestExpandTemplate(t *testing.T) {
+	vars := map[string]string{"date": "2026-02-04", "customer": "Acme", "type": ""}
+
+	cases := []struct {
+		tmpl   string
+		vars   map[string]string
+		expect string
+	}{
+		{"{date}-{customer}-cadence-call-summary.md", vars, "2026-02-04-Acme-cadence-call-summary.md"},
+		{"{date}-{customer}-cadence-call-summary.md", map[string]string{"customer": "Acme"}, "Acme-cadence-call-summary.md"},
+		{"{date} {type} {customer}.md", vars, "2026-02-04 Acme.md"},
+		{"Meetings/{customer}/{date} note.md", vars, "Meetings/Acme/2026-02-04 note.md"},
+		{"{customer}.md", map[string]string{"customer": "A/B: C"}, "A-B- C.md"},
+		{"{unknown}-{customer}", vars, "Acme"},
+	}
+
+	for _, tc := range cases {
+		if got := ExpandTemplate(tc.tmpl, tc.vars); got != tc.expect {
+			t.Errorf("ExpandTemplate(%q) = %q, want %q", tc.tmpl, got, tc.expect)
+		}
+	}
+}
+
+func TestExpandText(t *testing.T) {
+	vars := map[string]string{"date": "2026-02-04", "customer": "A/B Corp", "provider": ""}
+
+	cases := []struct {
+		tmpl   string
+		expect string
+	}{
+		{"{customer} follow-up {date}", "A/B Corp follow-up 2026-02-04"},
+		{"Add {customer} summary for {date} ({provider})", "Add A/B Corp summary for 2026-02-04"},
+		{"{unknown} {customer}", "A/B Corp"},
+		{"{unknown}", ""},
+	}
+
+	for _, tc := range cases {
+		if got := ExpandText(tc.tmpl, vars); got != tc.expect {
+			t.Errorf("ExpandText(%q) = %q, want %q", tc.tmpl, got, tc.expect)
+		}
+	}
+}
+
+func TestFollowUpEmailSubject(t *testing.T) {
+	meetingDir := filepath.Join(t.TempDir(), "Customers", "Acme", "2026-02-04")
+	if err := os.MkdirAll(meetingDir, 0755); err != nil {
+		t.Fatalf("failed to create meeting dir: %v", err)
+	}
+	p := newTestProcessor(t, meetingDir)
+
+	p.config.Email.SubjectTemplate = "{customer} {type} recap"
+	if got := p.FollowUpEmailSubject(); got != "Acme cadence-call recap" {
+		t.Errorf("unexpected subject %q", got)
+	}
+	p.config.Email.SubjectTemplate = "{unknown}"
+	if got := p.FollowUpEmailSubject(); got != "Acme follow-up 2026-02-04" {
+		t.Errorf("expected the default subject, got %q", got)
+	}
+}
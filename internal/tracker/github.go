@@ -0,0 +1,63 @@
+package tracker
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// GitHubClient creates issues in a GitHub repository via the REST API.
+type GitHubClient struct {
+	APIURL     string
+	Token      string
+	Repo       string // owner/name
+	httpClient *http.Client
+}
+
+// NewGitHubClient creates a GitHub client for owner/name.
+func NewGitHubClient(apiURL, token, repo string) (*GitHubClient, error) {
+	if strings.Count(repo, "/") != 1 || strings.HasPrefix(repo, "/") || strings.HasSuffix(repo, "/") {
+		return nil, fmt.Errorf("trackers.github.repo must be owner/name, got %q", repo)
+	}
+	if strings.TrimSpace(token) == "" {
+		return nil, fmt.Errorf("trackers.github.token or GITHUB_TOKEN is required")
+	}
+	return &GitHubClient{
+		APIURL:     strings.TrimRight(apiURL, "/"),
+		Token:      token,
+		Repo:       repo,
+		httpClient: newHTTPClient(),
+	}, nil
+}
+
+// CreateIssue creates the issue. GitHub issues have no due date field, so the
+// deadline stays in the body.
+func (c *GitHubClient) CreateIssue(ctx context.Context, issue Issue) (Created, error) {
+	payload := map[string]any{
+		"title": issue.Title,
+		"body":  issue.Body,
+	}
+	if len(issue.Assignees) > 0 {
+		payload["assignees"] = issue.Assignees
+	}
+	if len(issue.Labels) > 0 {
+		payload["labels"] = issue.Labels
+	}
+
+	var created struct {
+		Number  int    `json:"number"`
+		HTMLURL string `json:"html_url"`
+	}
+	headers := map[string]string{
+		"Authorization":        "Bearer " + c.Token,
+		"Accept":               "application/vnd.github+json",
+		"X-GitHub-Api-Version": "2022-11-28",
+	}
+	if err := doJSON(ctx, c.httpClient, http.MethodPost, c.APIURL+"/repos/"+c.Repo+"/issues", headers, payload, &created); err != nil {
+		return Created{}, fmt.Errorf("github: %w", err)
+	}
+
+	return Created{ID: "#" + strconv.Itoa(created.Number), URL: created.HTMLURL}, nil
+}
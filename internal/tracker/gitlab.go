@@ -0,0 +1,90 @@
+package tracker
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// GitLabClient creates issues in a GitLab project via the REST API.
+type GitLabClient struct {
+	APIURL     string
+	Token      string
+	Project    string // numeric ID or group/project path
+	httpClient *http.Client
+}
+
+// NewGitLabClient creates a GitLab client for a project ID or path.
+func NewGitLabClient(apiURL, token, project string) (*GitLabClient, error) {
+	if strings.TrimSpace(project) == "" {
+		return nil, fmt.Errorf("trackers.gitlab.project is required")
+	}
+	if strings.TrimSpace(token) == "" {
+		return nil, fmt.Errorf("trackers.gitlab.token or GITLAB_TOKEN is required")
+	}
+	return &GitLabClient{
+		APIURL:     strings.TrimRight(apiURL, "/"),
+		Token:      token,
+		Project:    project,
+		httpClient: newHTTPClient(),
+	}, nil
+}
+
+// CreateIssue creates the issue. GitLab assigns by user ID, so usernames are
+// looked up first.
+func (c *GitLabClient) CreateIssue(ctx context.Context, issue Issue) (Created, error) {
+	payload := map[string]any{
+		"title":       issue.Title,
+		"description": issue.Body,
+	}
+	if len(issue.Labels) > 0 {
+		payload["labels"] = strings.Join(issue.Labels, ",")
+	}
+	if !issue.Due.IsZero() {
+		payload["due_date"] = issue.Due.Format("2006-01-02")
+	}
+
+	if len(issue.Assignees) > 0 {
+		ids := make([]int, 0, len(issue.Assignees))
+		for _, username := range issue.Assignees {
+			id, err := c.userID(ctx, username)
+			if err != nil {
+				return Created{}, err
+			}
+			ids = append(ids, id)
+		}
+		payload["assignee_ids"] = ids
+	}
+
+	var created struct {
+		IID    int    `json:"iid"`
+		WebURL string `json:"web_url"`
+	}
+	endpoint := c.APIURL + "/projects/" + url.PathEscape(c.Project) + "/issues"
+	if err := doJSON(ctx, c.httpClient, http.MethodPost, endpoint, c.headers(), payload, &created); err != nil {
+		return Created{}, fmt.Errorf("gitlab: %w", err)
+	}
+
+	return Created{ID: "#" + strconv.Itoa(created.IID), URL: created.WebURL}, nil
+}
+
+func (c *GitLabClient) userID(ctx context.Context, username string) (int, error) {
+	var users []struct {
+		ID int `json:"id"`
+	}
+	endpoint := c.APIURL + "/users?username=" + url.QueryEscape(username)
+	if err := doJSON(ctx, c.httpClient, http.MethodGet, endpoint, c.headers(), nil, &users); err != nil {
+		return 0, fmt.Errorf("gitlab: failed to look up user %s: %w", username, err)
+	}
+	if len(users) == 0 {
+		return 0, fmt.Errorf("gitlab: user %s not found", username)
+	}
+	return users[0].ID, nil
+}
+
+func (c *GitLabClient) headers() map[string]string {
+	return map[string]string{"PRIVATE-TOKEN": c.Token}
+}
@@ -0,0 +1,76 @@
+package tracker
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// JiraClient creates issues in a Jira project via the REST API v2, which
+// accepts plain-text descriptions.
+type JiraClient struct {
+	URL        string
+	Email      string
+	Token      string
+	Project    string
+	IssueType  string
+	httpClient *http.Client
+}
+
+// NewJiraClient creates a Jira client authenticating with email + API token.
+func NewJiraClient(baseURL, email, token, project, issueType string) (*JiraClient, error) {
+	switch {
+	case strings.TrimSpace(baseURL) == "":
+		return nil, fmt.Errorf("trackers.jira.url is required")
+	case strings.TrimSpace(project) == "":
+		return nil, fmt.Errorf("trackers.jira.project is required")
+	case strings.TrimSpace(email) == "":
+		return nil, fmt.Errorf("trackers.jira.email is required")
+	case strings.TrimSpace(token) == "":
+		return nil, fmt.Errorf("trackers.jira.token or JIRA_API_TOKEN is required")
+	}
+	if strings.TrimSpace(issueType) == "" {
+		issueType = "Task"
+	}
+	return &JiraClient{
+		URL:        strings.TrimRight(baseURL, "/"),
+		Email:      email,
+		Token:      token,
+		Project:    project,
+		IssueType:  issueType,
+		httpClient: newHTTPClient(),
+	}, nil
+}
+
+// CreateIssue creates the issue. Jira issues take a single assignee, so only
+// the first mapped account ID is used.
+func (c *JiraClient) CreateIssue(ctx context.Context, issue Issue) (Created, error) {
+	fields := map[string]any{
+		"project":     map[string]string{"key": c.Project},
+		"issuetype":   map[string]string{"name": c.IssueType},
+		"summary":     issue.Title,
+		"description": issue.Body,
+	}
+	if len(issue.Assignees) > 0 {
+		fields["assignee"] = map[string]string{"accountId": issue.Assignees[0]}
+	}
+	if len(issue.Labels) > 0 {
+		fields["labels"] = issue.Labels
+	}
+	if !issue.Due.IsZero() {
+		fields["duedate"] = issue.Due.Format("2006-01-02")
+	}
+
+	var created struct {
+		Key string `json:"key"`
+	}
+	credentials := base64.StdEncoding.EncodeToString([]byte(c.Email + ":" + c.Token))
+	headers := map[string]string{"Authorization": "Basic " + credentials}
+	if err := doJSON(ctx, c.httpClient, http.MethodPost, c.URL+"/rest/api/2/issue", headers, map[string]any{"fields": fields}, &created); err != nil {
+		return Created{}, fmt.Errorf("jira: %w", err)
+	}
+
+	return Created{ID: created.Key, URL: c.URL + "/browse/" + created.Key}, nil
+}
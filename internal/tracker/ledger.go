@@ -0,0 +1,58 @@
+package tracker
+
+import (
+	"os"
+	"time"
+
+	"github.com/bashfulrobot/meetsum/internal/summary"
+)
+
+// LedgerFile is the state file recording issues created from a meeting.
+const LedgerFile = "tracker-issues.json"
+
+// Record ties an action item to the issue created for it.
+type Record struct {
+	Tracker   string    `json:"tracker"`
+	ItemUID   string    `json:"item_uid"`
+	Task      string    `json:"task"`
+	Assignee  string    `json:"assignee,omitempty"`
+	IssueID   string    `json:"issue_id"`
+	IssueURL  string    `json:"issue_url,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Ledger lists issues already created for a meeting so pushes are not repeated.
+type Ledger struct {
+	Issues []Record `json:"issues"`
+
+	meetingDir string
+}
+
+// LoadLedger reads the meeting's ledger; a missing file yields an empty ledger.
+func LoadLedger(meetingDir string) (*Ledger, error) {
+	ledger := &Ledger{meetingDir: meetingDir}
+	if err := summary.ReadStateFile(meetingDir, LedgerFile, ledger); err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	return ledger, nil
+}
+
+// Find returns the record for an item in a tracker.
+func (l *Ledger) Find(tracker, itemUID string) (Record, bool) {
+	for _, record := range l.Issues {
+		if record.Tracker == tracker && record.ItemUID == itemUID {
+			return record, true
+		}
+	}
+	return Record{}, false
+}
+
+// Add appends a record.
+func (l *Ledger) Add(record Record) {
+	l.Issues = append(l.Issues, record)
+}
+
+// Save writes the ledger to the meeting's state directory.
+func (l *Ledger) Save() (string, error) {
+	return summary.WriteStateFile(l.meetingDir, LedgerFile, l)
+}
@@ -0,0 +1,96 @@
+package tracker
+
+import (
+	"context"
+	"time"
+
+	"github.com/bashfulrobot/meetsum/internal/actions"
+)
+
+// PushOptions configures a push of action items to one tracker.
+type PushOptions struct {
+	Tracker string
+	Client  Client
+	Ledger  *Ledger
+	Labels  []string
+	// UserFor maps a person named in the summary to a tracker username; "" means unmapped.
+	UserFor func(person string) string
+}
+
+// PushResult reports what happened to one action item.
+type PushResult struct {
+	Item     actions.Item
+	Record   Record
+	Existing bool
+	Unmapped []string
+	Err      error
+}
+
+// Pending splits items into those without an issue in the tracker yet and the
+// records of those already created.
+func (l *Ledger) Pending(tracker string, items []actions.Item, meeting actions.Meeting) ([]actions.Item, []Record) {
+	var pending []actions.Item
+	var done []Record
+	for _, item := range items {
+		if record, ok := l.Find(tracker, actions.ItemUID(item, meeting)); ok {
+			done = append(done, record)
+			continue
+		}
+		pending = append(pending, item)
+	}
+	return pending, done
+}
+
+// MapAssignees resolves each person in an item's assignee field to a tracker
+// username, returning the names that have no mapping.
+func MapAssignees(assignee string, userFor func(person string) string) (mapped, unmapped []string) {
+	for _, person := range actions.SplitAssignees(assignee) {
+		if username := userFor(person); username != "" {
+			mapped = append(mapped, username)
+		} else {
+			unmapped = append(unmapped, person)
+		}
+	}
+	return mapped, unmapped
+}
+
+// Push creates an issue for every item not already recorded in the ledger. The
+// ledger is saved after each creation so an interrupted push never duplicates
+// issues on the next run. Per-item failures are reported in the results; the
+// returned error is only set when the ledger cannot be saved.
+func Push(ctx context.Context, opts PushOptions, items []actions.Item, meeting actions.Meeting) ([]PushResult, error) {
+	results := make([]PushResult, 0, len(items))
+
+	for _, item := range items {
+		uid := actions.ItemUID(item, meeting)
+		if record, ok := opts.Ledger.Find(opts.Tracker, uid); ok {
+			results = append(results, PushResult{Item: item, Record: record, Existing: true})
+			continue
+		}
+
+		mapped, unmapped := MapAssignees(item.Assignee, opts.UserFor)
+		created, err := opts.Client.CreateIssue(ctx, BuildIssue(item, meeting, mapped, opts.Labels))
+		if err != nil {
+			results = append(results, PushResult{Item: item, Unmapped: unmapped, Err: err})
+			continue
+		}
+
+		record := Record{
+			Tracker:   opts.Tracker,
+			ItemUID:   uid,
+			Task:      item.Task,
+			Assignee:  item.Assignee,
+			IssueID:   created.ID,
+			IssueURL:  created.URL,
+			CreatedAt: time.Now(),
+		}
+		opts.Ledger.Add(record)
+		results = append(results, PushResult{Item: item, Record: record, Unmapped: unmapped})
+
+		if _, err := opts.Ledger.Save(); err != nil {
+			return results, err
+		}
+	}
+
+	return results, nil
+}
@@ -0,0 +1,125 @@
+package tracker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/bashfulrobot/meetsum/internal/actions"
+)
+
+// Supported issue trackers.
+const (
+	GitHub = "github"
+	GitLab = "gitlab"
+	Jira   = "jira"
+)
+
+// Names lists the supported trackers in display order.
+var Names = []string{GitHub, GitLab, Jira}
+
+// maxTitleLength keeps issue titles readable in tracker lists.
+const maxTitleLength = 120
+
+// Issue is a tracker-neutral issue built from an action item.
+type Issue struct {
+	Title     string
+	Body      string
+	Assignees []string
+	Labels    []string
+	Due       time.Time
+}
+
+// Created identifies an issue created in a tracker.
+type Created struct {
+	ID  string
+	URL string
+}
+
+// Client creates issues in one tracker.
+type Client interface {
+	CreateIssue(ctx context.Context, issue Issue) (Created, error)
+}
+
+// BuildIssue turns an action item into an issue whose body references the
+// meeting and summary file it came from.
+func BuildIssue(item actions.Item, meeting actions.Meeting, assignees, labels []string) Issue {
+	title := strings.TrimSuffix(strings.TrimSpace(item.Task), ".")
+	if meeting.Customer != "" {
+		title = fmt.Sprintf("[%s] %s", meeting.Customer, title)
+	}
+	if utf8.RuneCountInString(title) > maxTitleLength {
+		title = string([]rune(title)[:maxTitleLength-1]) + "…"
+	}
+
+	var body strings.Builder
+	body.WriteString(item.Task)
+	body.WriteString("\n\n")
+	body.WriteString(actions.Reference(item, meeting))
+	if item.HasDue() {
+		fmt.Fprintf(&body, "\nDue: %s", item.Due.Format("2006-01-02"))
+	}
+	fmt.Fprintf(&body, "\n\nCreated by meetsum (item %s)\n", actions.ItemUID(item, meeting))
+
+	return Issue{
+		Title:     title,
+		Body:      body.String(),
+		Assignees: assignees,
+		Labels:    labels,
+		Due:       item.Due,
+	}
+}
+
+// newHTTPClient returns the HTTP client shared by tracker clients.
+func newHTTPClient() *http.Client {
+	return &http.Client{Timeout: 30 * time.Second}
+}
+
+// doJSON sends payload as JSON (when non-nil) and decodes a 2xx response into out.
+func doJSON(ctx context.Context, client *http.Client, method, url string, headers map[string]string, payload, out any) error {
+	var body io.Reader = http.NoBody
+	if payload != nil {
+		encoded, err := json.Marshal(payload)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("HTTP %d: %s", resp.StatusCode, strings.TrimSpace(string(detail)))
+	}
+
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
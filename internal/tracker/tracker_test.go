@@ -0,0 +1,245 @@
+package tracker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bashfulrobot/meetsum/internal/actions"
+)
+
+var testMeeting = actions.Meeting{Customer: "Acme", Date: "2026-02-04", SummaryPath: "/c/Acme/2026-02-04/summary.md"}
+
+var testItems = []actions.Item{
+	{Assignee: "John Doe", Task: "Send the proposal document by Friday.", DueText: "by Friday", Due: time.Date(2026, 2, 6, 0, 0, 0, 0, time.UTC)},
+	{Assignee: "Jane Smith & Tester", Task: "Schedule a follow-up call."},
+}
+
+// recordingServer captures decoded JSON request bodies.
+type recordingServer struct {
+	mu     sync.Mutex
+	bodies []map[string]any
+}
+
+func (r *recordingServer) record(req *http.Request) map[string]any {
+	var body map[string]any
+	_ = json.NewDecoder(req.Body).Decode(&body)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.bodies = append(r.bodies, body)
+	return body
+}
+
+func TestBuildIssue(t *testing.T) {
+	issue := BuildIssue(testItems[0], testMeeting, []string{"jdoe"}, []string{"meeting"})
+
+	if issue.Title != "[Acme] Send the proposal document by Friday" {
+		t.Errorf("unexpected title: %q", issue.Title)
+	}
+	for _, expected := range []string{"Summary: /c/Acme/2026-02-04/summary.md", "Deadline: by Friday", "Due: 2026-02-06", actions.ItemUID(testItems[0], testMeeting)} {
+		if !strings.Contains(issue.Body, expected) {
+			t.Errorf("expected body to contain %q:\n%s", expected, issue.Body)
+		}
+	}
+
+	long := actions.Item{Task: strings.Repeat("word ", 60)}
+	if got := []rune(BuildIssue(long, testMeeting, nil, nil).Title); len(got) != maxTitleLength {
+		t.Errorf("expected title truncated to %d runes, got %d", maxTitleLength, len(got))
+	}
+}
+
+func TestGitHubClient(t *testing.T) {
+	server := &recordingServer{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		server.record(r)
+		if r.URL.Path != "/repos/acme/ops/issues" || r.Header.Get("Authorization") != "Bearer gh-token" {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"number": 42, "html_url": "https://github.example/acme/ops/issues/42"}`)
+	}))
+	defer ts.Close()
+
+	client, err := NewGitHubClient(ts.URL, "gh-token", "acme/ops")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	created, err := client.CreateIssue(context.Background(), BuildIssue(testItems[0], testMeeting, []string{"jdoe"}, []string{"meeting"}))
+	if err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+	if created.ID != "#42" || created.URL != "https://github.example/acme/ops/issues/42" {
+		t.Errorf("unexpected created issue: %+v", created)
+	}
+
+	body := server.bodies[0]
+	if assignees, _ := body["assignees"].([]any); len(assignees) != 1 || assignees[0] != "jdoe" {
+		t.Errorf("unexpected assignees: %v", body["assignees"])
+	}
+
+	if _, err := NewGitHubClient(ts.URL, "gh-token", "ops"); err == nil {
+		t.Error("expected owner/name validation error")
+	}
+}
+
+func TestGitLabClient(t *testing.T) {
+	server := &recordingServer{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("PRIVATE-TOKEN") != "gl-token" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/users":
+			if r.URL.Query().Get("username") == "jdoe" {
+				fmt.Fprint(w, `[{"id": 7}]`)
+				return
+			}
+			fmt.Fprint(w, `[]`)
+		case r.Method == http.MethodPost && r.URL.EscapedPath() == "/projects/acme%2Fops/issues":
+			server.record(r)
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"iid": 3, "web_url": "https://gitlab.example/acme/ops/-/issues/3"}`)
+		default:
+			http.Error(w, "not found: "+r.URL.EscapedPath(), http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewGitLabClient(ts.URL, "gl-token", "acme/ops")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	created, err := client.CreateIssue(context.Background(), BuildIssue(testItems[0], testMeeting, []string{"jdoe"}, []string{"meeting", "customer"}))
+	if err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+	if created.ID != "#3" {
+		t.Errorf("unexpected created issue: %+v", created)
+	}
+
+	body := server.bodies[0]
+	if body["due_date"] != "2026-02-06" || body["labels"] != "meeting,customer" {
+		t.Errorf("unexpected payload: %v", body)
+	}
+	if ids, _ := body["assignee_ids"].([]any); len(ids) != 1 || ids[0] != float64(7) {
+		t.Errorf("unexpected assignee ids: %v", body["assignee_ids"])
+	}
+
+	if _, err := client.CreateIssue(context.Background(), Issue{Title: "x", Assignees: []string{"ghost"}}); err == nil {
+		t.Error("expected unknown user error")
+	}
+}
+
+func TestJiraClient(t *testing.T) {
+	server := &recordingServer{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, token, ok := r.BasicAuth()
+		if r.URL.Path != "/rest/api/2/issue" || !ok || user != "me@example.com" || token != "jira-token" {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		server.record(r)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": "10001", "key": "OPS-12"}`)
+	}))
+	defer ts.Close()
+
+	client, err := NewJiraClient(ts.URL+"/", "me@example.com", "jira-token", "OPS", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	created, err := client.CreateIssue(context.Background(), BuildIssue(testItems[0], testMeeting, []string{"acc-1", "acc-2"}, nil))
+	if err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+	if created.ID != "OPS-12" || created.URL != ts.URL+"/browse/OPS-12" {
+		t.Errorf("unexpected created issue: %+v", created)
+	}
+
+	fields, _ := server.bodies[0]["fields"].(map[string]any)
+	if assignee, _ := fields["assignee"].(map[string]any); assignee["accountId"] != "acc-1" {
+		t.Errorf("expected first account as assignee, got %v", fields["assignee"])
+	}
+	if issueType, _ := fields["issuetype"].(map[string]any); issueType["name"] != "Task" {
+		t.Errorf("expected default issue type, got %v", fields["issuetype"])
+	}
+	if fields["duedate"] != "2026-02-06" {
+		t.Errorf("unexpected due date: %v", fields["duedate"])
+	}
+}
+
+type fakeClient struct {
+	issues []Issue
+	fail   string
+}
+
+func (f *fakeClient) CreateIssue(_ context.Context, issue Issue) (Created, error) {
+	if f.fail != "" && strings.Contains(issue.Title, f.fail) {
+		return Created{}, fmt.Errorf("tracker unavailable")
+	}
+	f.issues = append(f.issues, issue)
+	return Created{ID: fmt.Sprintf("#%d", len(f.issues)), URL: fmt.Sprintf("https://tracker.example/%d", len(f.issues))}, nil
+}
+
+func TestPushRecordsIssuesAndSkipsDuplicates(t *testing.T) {
+	meetingDir := t.TempDir()
+	userFor := func(person string) string {
+		if person == "John Doe" {
+			return "jdoe"
+		}
+		return ""
+	}
+
+	ledger, err := LoadLedger(meetingDir)
+	if err != nil {
+		t.Fatalf("load failed: %v", err)
+	}
+	client := &fakeClient{fail: "follow-up"}
+	opts := PushOptions{Tracker: GitHub, Client: client, Ledger: ledger, UserFor: userFor}
+
+	results, err := Push(context.Background(), opts, testItems, testMeeting)
+	if err != nil {
+		t.Fatalf("push failed: %v", err)
+	}
+	if results[0].Err != nil || results[0].Record.IssueID != "#1" || len(client.issues[0].Assignees) != 1 {
+		t.Errorf("unexpected first result: %+v", results[0])
+	}
+	if results[1].Err == nil || len(results[1].Unmapped) != 2 {
+		t.Errorf("expected failure with unmapped assignees, got %+v", results[1])
+	}
+
+	// A second run reloads the ledger, skips the created item and retries the failed one.
+	reloaded, err := LoadLedger(meetingDir)
+	if err != nil {
+		t.Fatalf("reload failed: %v", err)
+	}
+	pending, done := reloaded.Pending(GitHub, testItems, testMeeting)
+	if len(pending) != 1 || len(done) != 1 || done[0].IssueID != "#1" {
+		t.Fatalf("unexpected pending split: %v / %v", pending, done)
+	}
+
+	client.fail = ""
+	opts.Ledger = reloaded
+	results, err = Push(context.Background(), opts, testItems, testMeeting)
+	if err != nil {
+		t.Fatalf("second push failed: %v", err)
+	}
+	if !results[0].Existing || results[1].Err != nil {
+		t.Errorf("unexpected second run results: %+v", results)
+	}
+	if len(client.issues) != 2 {
+		t.Errorf("expected exactly two issues created overall, got %d", len(client.issues))
+	}
+
+	if pending, _ := reloaded.Pending(GitLab, testItems, testMeeting); len(pending) != 2 {
+		t.Error("expected ledger entries to be per tracker")
+	}
+}
@@ -0,0 +1,170 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+)
+
+// BoardUpdate changes the status of one row on a progress board.
+type BoardUpdate struct {
+	Index  int
+	Status string
+	// Active rows show a spinner and elapsed time.
+	Active bool
+	Done   bool
+	Failed bool
+}
+
+type boardRow struct {
+	label   string
+	update  BoardUpdate
+	started time.Time
+	elapsed time.Duration
+}
+
+type boardFinishedMsg struct{}
+
+type boardModel struct {
+	title   string
+	rows    []boardRow
+	spinner spinner.Model
+	width   int
+	cancel  context.CancelFunc
+	done    bool
+}
+
+func newBoardModel(title string, labels []string, cancel context.CancelFunc) boardModel {
+	s := spinner.New()
+	s.Spinner = spinner.Dot
+	s.Style = AccentStyle
+
+	model := boardModel{title: title, spinner: s, cancel: cancel}
+	for i, label := range labels {
+		model.rows = append(model.rows, boardRow{label: label, update: BoardUpdate{Index: i, Status: "queued"}})
+		model.width = max(model.width, lipgloss.Width(label))
+	}
+	return model
+}
+
+func (m boardModel) Init() tea.Cmd {
+	return m.spinner.Tick
+}
+
+func (m boardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if msg.String() == "ctrl+c" {
+			// Stop starting new work; running rows finish on their own
+			m.cancel()
+		}
+	case BoardUpdate:
+		if msg.Index >= 0 && msg.Index < len(m.rows) {
+			row := &m.rows[msg.Index]
+			if msg.Active && row.started.IsZero() {
+				row.started = time.Now()
+			}
+			if (msg.Done || msg.Failed) && !row.started.IsZero() {
+				row.elapsed = time.Since(row.started)
+			}
+			row.update = msg
+		}
+	case boardFinishedMsg:
+		m.done = true
+		return m, tea.Quit
+	default:
+		var cmd tea.Cmd
+		m.spinner, cmd = m.spinner.Update(msg)
+		return m, cmd
+	}
+	return m, nil
+}
+
+func (m boardModel) View() string {
+	var output strings.Builder
+	if m.title != "" {
+		output.WriteString(InfoStyle.Render(m.title))
+		output.WriteString("\n\n")
+	}
+
+	finished := 0
+	for _, row := range m.rows {
+		icon := SecondaryStyle.Render("•")
+		status := SecondaryStyle.Render(row.update.Status)
+		switch {
+		case row.update.Failed:
+			icon = "❌"
+			status = ErrorStyle.Render(row.update.Status)
+		case row.update.Done:
+			icon = "✅"
+			status = SuccessStyle.Render(row.update.Status)
+		case row.update.Active:
+			icon = m.spinner.View()
+			status = InfoStyle.Render(row.update.Status)
+		}
+		if row.update.Done || row.update.Failed {
+			finished++
+		}
+
+		elapsed := ""
+		switch {
+		case row.elapsed > 0:
+			elapsed = row.elapsed.Round(time.Second).String()
+		case !row.started.IsZero():
+			elapsed = time.Since(row.started).Round(time.Second).String()
+		}
+
+		label := row.label + strings.Repeat(" ", m.width-lipgloss.Width(row.label))
+		fmt.Fprintf(&output, "  %s %s  %s %s\n", icon, label, status, SecondaryStyle.Render(elapsed))
+	}
+
+	if !m.done {
+		fmt.Fprintf(&output, "\n%s", SecondaryStyle.Render(fmt.Sprintf("%d/%d finished · Ctrl+C stops queued work", finished, len(m.rows))))
+	}
+	return output.String()
+}
+
+// RunBoard shows a live progress board with one row per label while work
+// runs. work receives a context that is canceled when the user presses
+// Ctrl+C and a function to update rows. The board closes when work returns.
+func RunBoard(title string, labels []string, work func(ctx context.Context, update func(BoardUpdate))) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	p := tea.NewProgram(newBoardModel(title, labels, cancel))
+	finished := make(chan struct{})
+	go func() {
+		defer close(finished)
+		work(ctx, func(update BoardUpdate) { p.Send(update) })
+		p.Send(boardFinishedMsg{})
+	}()
+
+	_, err := p.Run()
+	if err != nil {
+		cancel()
+	}
+	<-finished
+	return err
+}
+
+// RenderTable renders a static bordered table for printing.
+func RenderTable(headers []string, rows [][]string) string {
+	return table.New().
+		Border(lipgloss.RoundedBorder()).
+		BorderStyle(SecondaryStyle).
+		StyleFunc(func(row, _ int) lipgloss.Style {
+			if row == table.HeaderRow {
+				return lipgloss.NewStyle().Foreground(HeaderColor).Bold(true).Padding(0, 1)
+			}
+			return lipgloss.NewStyle().Padding(0, 1)
+		}).
+		Headers(headers...).
+		Rows(rows...).
+		Render()
+}
@@ -0,0 +1,32 @@
+package ui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	diffFileStyle    = lipgloss.NewStyle().Foreground(HeaderColor).Bold(true)
+	diffAddedStyle   = lipgloss.NewStyle().Foreground(SuccessColor)
+	diffRemovedStyle = lipgloss.NewStyle().Foreground(ErrorColor)
+)
+
+// RenderDiff colors a unified diff: file headers bold, additions green,
+// removals red and hunk headers in the accent color.
+func RenderDiff(diff string) string {
+	lines := strings.Split(strings.TrimRight(diff, "\n"), "\n")
+	for i, line := range lines {
+		switch {
+		case strings.HasPrefix(line, "+++"), strings.HasPrefix(line, "---"):
+			lines[i] = diffFileStyle.Render(line)
+		case strings.HasPrefix(line, "@@"):
+			lines[i] = AccentStyle.Render(line)
+		case strings.HasPrefix(line, "+"):
+			lines[i] = diffAddedStyle.Render(line)
+		case strings.HasPrefix(line, "-"):
+			lines[i] = diffRemovedStyle.Render(line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
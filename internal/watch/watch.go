@@ -0,0 +1,272 @@
+package watch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bashfulrobot/meetsum/config"
+	"github.com/bashfulrobot/meetsum/internal/meetings"
+	"github.com/bashfulrobot/meetsum/internal/summary"
+	"github.com/charmbracelet/log"
+	"github.com/fsnotify/fsnotify"
+)
+
+// rawOutputFile is written by meetsum when a summary fails validation. It is a
+// .txt file, so it must never count as a new transcript.
+const rawOutputFile = "summary-raw-output.txt"
+
+// settleTime is how long events in a directory are ignored after meetsum
+// finishes processing it, so late events for its own writes are dropped.
+const settleTime = 2 * time.Second
+
+// Handler processes a meeting directory that gained a transcript and has no
+// summary yet. Handlers run one at a time.
+type Handler func(meeting meetings.Meeting)
+
+// Watcher monitors a directory tree for new transcripts.
+type Watcher struct {
+	cfg      *config.Config
+	root     string
+	debounce time.Duration
+	handle   Handler
+	logger   *log.Logger
+
+	fsWatcher *fsnotify.Watcher
+	ready     chan string
+	done      chan struct{}
+
+	mu          sync.Mutex
+	timers      map[string]*time.Timer
+	queued      map[string]bool
+	busy        map[string]bool
+	ignoreUntil map[string]time.Time
+}
+
+// New creates a watcher for root. A directory is handled once no transcript
+// event has arrived for the debounce period.
+func New(cfg *config.Config, root string, debounce time.Duration, handle Handler, logger *log.Logger) (*Watcher, error) {
+	info, err := os.Stat(root)
+	if err != nil {
+		return nil, fmt.Errorf("cannot watch %s: %w", root, err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("cannot watch %s: not a directory", root)
+	}
+
+	fsWatcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("failed to start file watcher: %w", err)
+	}
+
+	w := &Watcher{
+		cfg:         cfg,
+		root:        root,
+		debounce:    debounce,
+		handle:      handle,
+		logger:      logger,
+		fsWatcher:   fsWatcher,
+		ready:       make(chan string, 64),
+		done:        make(chan struct{}),
+		timers:      make(map[string]*time.Timer),
+		queued:      make(map[string]bool),
+		busy:        make(map[string]bool),
+		ignoreUntil: make(map[string]time.Time),
+	}
+	if err := w.addTree(root, false); err != nil {
+		_ = fsWatcher.Close()
+		return nil, err
+	}
+	return w, nil
+}
+
+// Run processes events until ctx is canceled. Directories that already have
+// a transcript when watching starts are left alone.
+func (w *Watcher) Run(ctx context.Context) error {
+	defer w.fsWatcher.Close()
+	defer close(w.done)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		w.process(ctx)
+	}()
+	defer wg.Wait()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.stopTimers()
+			return nil
+		case event, ok := <-w.fsWatcher.Events:
+			if !ok {
+				return nil
+			}
+			w.handleEvent(event)
+		case err, ok := <-w.fsWatcher.Errors:
+			if !ok {
+				return nil
+			}
+			if w.logger != nil {
+				w.logger.Warn("file watcher error", "error", err)
+			}
+		}
+	}
+}
+
+// Directories returns the number of directories being watched.
+func (w *Watcher) Directories() int {
+	return len(w.fsWatcher.WatchList())
+}
+
+func (w *Watcher) handleEvent(event fsnotify.Event) {
+	if !event.Has(fsnotify.Create) && !event.Has(fsnotify.Write) {
+		return
+	}
+
+	if event.Has(fsnotify.Create) {
+		if info, err := os.Stat(event.Name); err == nil && info.IsDir() {
+			if !isHidden(filepath.Base(event.Name)) {
+				// Files may land before the watch is added, so scan the new tree
+				if err := w.addTree(event.Name, true); err != nil && w.logger != nil {
+					w.logger.Warn("failed to watch new directory", "path", event.Name, "error", err)
+				}
+			}
+			return
+		}
+	}
+
+	if isTranscriptEvent(event.Name) {
+		w.schedule(filepath.Dir(event.Name))
+	}
+}
+
+// addTree watches dir and its non-hidden subdirectories. When schedule is set,
+// directories that already contain transcript candidates are scheduled.
+func (w *Watcher) addTree(dir string, schedule bool) error {
+	return filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if path == dir {
+				return err
+			}
+			return fs.SkipDir
+		}
+		if !entry.IsDir() {
+			if schedule && isTranscriptEvent(path) {
+				w.schedule(filepath.Dir(path))
+			}
+			return nil
+		}
+		if path != dir && isHidden(entry.Name()) {
+			return fs.SkipDir
+		}
+		if err := w.fsWatcher.Add(path); err != nil {
+			return fmt.Errorf("failed to watch %s: %w", path, err)
+		}
+		return nil
+	})
+}
+
+// schedule (re)starts the debounce timer for a meeting directory, unless
+// meetsum is busy with it or just finished writing to it.
+func (w *Watcher) schedule(dir string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.busy[dir] || time.Now().Before(w.ignoreUntil[dir]) {
+		return
+	}
+	if timer, ok := w.timers[dir]; ok {
+		timer.Reset(w.debounce)
+		return
+	}
+	w.timers[dir] = time.AfterFunc(w.debounce, func() {
+		w.mu.Lock()
+		delete(w.timers, dir)
+		if w.queued[dir] {
+			w.mu.Unlock()
+			return
+		}
+		w.queued[dir] = true
+		w.mu.Unlock()
+
+		select {
+		case w.ready <- dir:
+		case <-w.done:
+		}
+	})
+}
+
+func (w *Watcher) stopTimers() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for dir, timer := range w.timers {
+		timer.Stop()
+		delete(w.timers, dir)
+	}
+}
+
+// process hands settled directories to the handler one at a time.
+func (w *Watcher) process(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case dir := <-w.ready:
+			w.mu.Lock()
+			delete(w.queued, dir)
+			w.busy[dir] = true
+			w.mu.Unlock()
+
+			w.inspectAndHandle(dir)
+
+			w.mu.Lock()
+			delete(w.busy, dir)
+			w.ignoreUntil[dir] = time.Now().Add(settleTime)
+			w.mu.Unlock()
+		}
+	}
+}
+
+func (w *Watcher) inspectAndHandle(dir string) {
+	meeting, err := meetings.Inspect(w.cfg, dir)
+	if err != nil {
+		if !errors.Is(err, fs.ErrNotExist) && w.logger != nil {
+			w.logger.Warn("failed to inspect meeting directory", "dir", dir, "error", err)
+		}
+		return
+	}
+
+	switch {
+	case meeting.HasSummary():
+		if w.logger != nil {
+			w.logger.Debug("skipping meeting with a summary", "dir", dir)
+		}
+	case meeting.Transcript == "":
+		if w.logger != nil {
+			w.logger.Warn("skipping meeting without a single transcript", "dir", dir, "reason", meeting.TranscriptError)
+		}
+	default:
+		w.handle(meeting)
+	}
+}
+
+// isTranscriptEvent reports whether a file path could be a new transcript.
+func isTranscriptEvent(path string) bool {
+	name := filepath.Base(path)
+	if !strings.EqualFold(filepath.Ext(name), ".txt") || strings.EqualFold(name, rawOutputFile) {
+		return false
+	}
+	return !strings.Contains(filepath.ToSlash(path), "/"+summary.StateDirName+"/")
+}
+
+func isHidden(name string) bool {
+	return strings.HasPrefix(name, ".")
+}
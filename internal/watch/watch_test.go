@@ -0,0 +1,104 @@
+package watch
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bashfulrobot/meetsum/config"
+	"github.com/bashfulrobot/meetsum/internal/meetings"
+)
+
+const testDebounce = 50 * time.Millisecond
+
+type recorder struct {
+	mu   sync.Mutex
+	dirs []string
+}
+
+func (r *recorder) handle(meeting meetings.Meeting) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.dirs = append(r.dirs, meeting.Dir)
+}
+
+func (r *recorder) handled() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.dirs...)
+}
+
+func TestWatcherHandlesNewTranscripts(t *testing.T) {
+	root := t.TempDir()
+	existing := filepath.Join(root, "Acme", "2025-10-01")
+	writeFile(t, filepath.Join(existing, "transcript.txt"), "already here")
+
+	summarized := filepath.Join(root, "Acme", "2025-10-02")
+	writeFile(t, filepath.Join(summarized, "2025-10-02-Acme-cadence-call-summary.md"), "done")
+
+	rec := &recorder{}
+	w, err := New(&config.Config{}, root, testDebounce, rec.handle, nil)
+	if err != nil {
+		t.Fatalf("failed to create watcher: %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	errs := make(chan error, 1)
+	go func() { errs <- w.Run(ctx) }()
+
+	fresh := filepath.Join(root, "Zeta", "2025-10-03")
+	writeFile(t, filepath.Join(fresh, "transcript.txt"), "part one")
+	writeFile(t, filepath.Join(fresh, "transcript.txt"), "part one, part two")
+	writeFile(t, filepath.Join(summarized, "late.txt"), "ignored, summary exists")
+	writeFile(t, filepath.Join(existing, "notes.md"), "not a transcript")
+	writeFile(t, filepath.Join(existing, rawOutputFile), "meetsum output")
+
+	deadline := time.Now().Add(3 * time.Second)
+	for len(rec.handled()) == 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	// Give stray or duplicate events time to show up
+	time.Sleep(4 * testDebounce)
+	cancel()
+	if err := <-errs; err != nil {
+		t.Fatalf("watcher returned an error: %v", err)
+	}
+
+	got := rec.handled()
+	if len(got) != 1 || got[0] != fresh {
+		t.Errorf("expected only %s to be handled once, got %v", fresh, got)
+	}
+}
+
+func TestIsTranscriptEvent(t *testing.T) {
+	tests := map[string]bool{
+		"/c/Acme/2025-10-01/transcript.txt":         true,
+		"/c/Acme/2025-10-01/Transcript.TXT":         true,
+		"/c/Acme/2025-10-01/summary.md":             false,
+		"/c/Acme/2025-10-01/summary-raw-output.txt": false,
+		"/c/Acme/2025-10-01/.meetsum/history/a.txt": false,
+	}
+	for path, want := range tests {
+		if got := isTranscriptEvent(path); got != want {
+			t.Errorf("isTranscriptEvent(%q) = %v, want %v", path, got, want)
+		}
+	}
+}
+
+func TestNewRejectsMissingRoot(t *testing.T) {
+	if _, err := New(&config.Config{}, filepath.Join(t.TempDir(), "missing"), testDebounce, func(meetings.Meeting) {}, nil); err == nil {
+		t.Error("expected an error for a missing root")
+	}
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatalf("failed to create %s: %v", filepath.Dir(path), err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write %s: %v", path, err)
+	}
+}
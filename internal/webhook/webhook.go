@@ -0,0 +1,165 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Event types.
+const (
+	EventRunSucceeded = "run.succeeded"
+	EventRunFailed    = "run.failed"
+)
+
+// Request headers. The signature is "sha256=" followed by the hex HMAC-SHA256
+// of the raw request body keyed with the configured secret.
+const (
+	SignatureHeader = "X-Meetsum-Signature"
+	EventHeader     = "X-Meetsum-Event"
+)
+
+// Event is the JSON payload posted after each run.
+type Event struct {
+	Event       string       `json:"event"`
+	Timestamp   time.Time    `json:"timestamp"`
+	Customer    string       `json:"customer"`
+	Date        string       `json:"date,omitempty"`
+	MeetingType string       `json:"meeting_type,omitempty"`
+	MeetingDir  string       `json:"meeting_dir"`
+	Provider    string       `json:"provider"`
+	DurationMS  int64        `json:"duration_ms"`
+	Outputs     Outputs      `json:"outputs"`
+	Highlights  []string     `json:"highlights,omitempty"`
+	ActionItems []ActionItem `json:"action_items,omitempty"`
+	Error       string       `json:"error,omitempty"`
+}
+
+// Outputs lists the files a run wrote. Empty fields were not produced.
+type Outputs struct {
+	Summary      string `json:"summary,omitempty"`
+	SlackSummary string `json:"slack_summary,omitempty"`
+	Transcript   string `json:"transcript,omitempty"`
+	Email        string `json:"email,omitempty"`
+	Note         string `json:"note,omitempty"`
+}
+
+// ActionItem is a parsed action item.
+type ActionItem struct {
+	Assignee string `json:"assignee,omitempty"`
+	Task     string `json:"task"`
+	Due      string `json:"due,omitempty"`
+}
+
+// Sender posts signed events with retries.
+type Sender struct {
+	Secret string
+	// Attempts is the number of tries per URL, including the first.
+	Attempts int
+	// Backoff is the delay before the first retry; it doubles after each attempt.
+	Backoff    time.Duration
+	httpClient *http.Client
+}
+
+// NewSender creates a sender that tries each URL up to attempts times.
+func NewSender(secret string, attempts int) *Sender {
+	if attempts < 1 {
+		attempts = 1
+	}
+	return &Sender{
+		Secret:     secret,
+		Attempts:   attempts,
+		Backoff:    time.Second,
+		httpClient: &http.Client{Timeout: 15 * time.Second},
+	}
+}
+
+// Sign returns the signature header value for body.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Send posts the event to every URL. Each URL is retried on network errors,
+// 429 and 5xx responses. Returns the joined errors of URLs that never accepted
+// the event.
+func (s *Sender) Send(ctx context.Context, urls []string, event Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode webhook event: %w", err)
+	}
+
+	var errs []error
+	for _, url := range urls {
+		if strings.TrimSpace(url) == "" {
+			continue
+		}
+		if err := s.deliver(ctx, url, event.Event, body); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", url, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (s *Sender) deliver(ctx context.Context, url, eventType string, body []byte) error {
+	delay := s.Backoff
+	var lastErr error
+
+	for attempt := 1; attempt <= s.Attempts; attempt++ {
+		retry, err := s.post(ctx, url, eventType, body)
+		if err == nil {
+			return nil
+		}
+		lastErr = err
+		if !retry || attempt == s.Attempts {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+
+	return fmt.Errorf("giving up after %d attempt(s): %w", s.Attempts, lastErr)
+}
+
+// post sends one request and reports whether a failure is worth retrying.
+func (s *Sender) post(ctx context.Context, url, eventType string, body []byte) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "meetsum-webhook")
+	req.Header.Set(EventHeader, eventType)
+	if s.Secret != "" {
+		req.Header.Set(SignatureHeader, Sign(s.Secret, body))
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode <= 299 {
+		return false, nil
+	}
+
+	detail, _ := io.ReadAll(io.LimitReader(resp.Body, 256))
+	err = fmt.Errorf("HTTP %d: %s", resp.StatusCode, strings.TrimSpace(string(detail)))
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500, err
+}
@@ -0,0 +1,105 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestSendSignsPayload(t *testing.T) {
+	var received Event
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.Header.Get(SignatureHeader) != Sign("s3cret", body) {
+			http.Error(w, "bad signature", http.StatusUnauthorized)
+			return
+		}
+		if r.Header.Get(EventHeader) != EventRunSucceeded {
+			http.Error(w, "bad event header", http.StatusBadRequest)
+			return
+		}
+		_ = json.Unmarshal(body, &received)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	event := Event{Event: EventRunSucceeded, Customer: "Acme", Highlights: []string{"Renewal confirmed"}}
+	if err := NewSender("s3cret", 1).Send(context.Background(), []string{ts.URL}, event); err != nil {
+		t.Fatalf("send failed: %v", err)
+	}
+	if received.Customer != "Acme" || len(received.Highlights) != 1 {
+		t.Errorf("unexpected payload: %+v", received)
+	}
+}
+
+func TestSign(t *testing.T) {
+	// printf 'body' | openssl dgst -sha256 -hmac key
+	expected := "sha256=515aae133b435d4000956731f68ae5cf5eb85d4f0dc6a546d2bfcd3595ec1ae1"
+	if got := Sign("key", []byte("body")); got != expected {
+		t.Fatalf("expected %s, got %s", expected, got)
+	}
+}
+
+func TestSendRetries(t *testing.T) {
+	t.Run("retries server errors until success", func(t *testing.T) {
+		var calls atomic.Int32
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if calls.Add(1) < 3 {
+				http.Error(w, "unavailable", http.StatusServiceUnavailable)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer ts.Close()
+
+		sender := NewSender("", 3)
+		sender.Backoff = time.Millisecond
+		if err := sender.Send(context.Background(), []string{ts.URL}, Event{Event: EventRunFailed}); err != nil {
+			t.Fatalf("expected eventual success, got %v", err)
+		}
+		if calls.Load() != 3 {
+			t.Errorf("expected 3 attempts, got %d", calls.Load())
+		}
+	})
+
+	t.Run("does not retry client errors", func(t *testing.T) {
+		var calls atomic.Int32
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			http.Error(w, "bad request", http.StatusBadRequest)
+		}))
+		defer ts.Close()
+
+		sender := NewSender("", 3)
+		sender.Backoff = time.Millisecond
+		err := sender.Send(context.Background(), []string{ts.URL}, Event{Event: EventRunSucceeded})
+		if err == nil || !strings.Contains(err.Error(), "HTTP 400") {
+			t.Fatalf("expected HTTP 400 error, got %v", err)
+		}
+		if calls.Load() != 1 {
+			t.Errorf("expected a single attempt, got %d", calls.Load())
+		}
+	})
+
+	t.Run("reports every failing url", func(t *testing.T) {
+		ok := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		defer ok.Close()
+		failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "boom", http.StatusInternalServerError)
+		}))
+		defer failing.Close()
+
+		sender := NewSender("", 2)
+		sender.Backoff = time.Millisecond
+		err := sender.Send(context.Background(), []string{ok.URL, failing.URL}, Event{Event: EventRunSucceeded})
+		if err == nil || !strings.Contains(err.Error(), failing.URL) || strings.Contains(err.Error(), ok.URL+":") {
+			t.Fatalf("expected only the failing URL in the error, got %v", err)
+		}
+	})
+}
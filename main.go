@@ -18,6 +18,6 @@ func main() {
 	cmd.SetVersion(Version, BuildTime, GitCommit)
 
 	if err := cmd.Execute(); err != nil {
-		os.Exit(1)
+		os.Exit(cmd.ExitCode(err))
 	}
 }
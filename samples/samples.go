@@ -0,0 +1,10 @@
+// Package samples bundles the sample files shipped with meetsum so commands
+// can scaffold them without a source checkout.
+package samples
+
+import _ "embed"
+
+// Instructions is the sample LLM instructions file.
+//
+//go:embed Meeting-summary-llm-instructions.md
+var Instructions []byte